@@ -0,0 +1,112 @@
+package main
+
+import (
+	"context"
+	"net/http"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/joho/godotenv"
+
+	"tech-management-backend/pkg/assignment"
+	"tech-management-backend/pkg/auth"
+	"tech-management-backend/pkg/config"
+	"tech-management-backend/pkg/db"
+	authhandlers "tech-management-backend/pkg/handlers/auth"
+	jobhandlers "tech-management-backend/pkg/handlers/jobs"
+	"tech-management-backend/pkg/handlers/policies"
+	"tech-management-backend/pkg/handlers/tasks"
+	"tech-management-backend/pkg/handlers/users"
+	"tech-management-backend/pkg/jobs"
+	"tech-management-backend/pkg/log"
+	"tech-management-backend/pkg/router"
+)
+
+const jobWorkers = 4
+
+func main() {
+	if err := godotenv.Load(); err != nil {
+		log.Warn("no .env file found, using system environment variables")
+	}
+	log.Configure()
+
+	cfg := config.Load()
+
+	conn, err := db.Connect(cfg)
+	if err != nil {
+		log.Fatal("could not connect to database", "error", err)
+	}
+	defer conn.Close()
+	log.Info("connected to database", "host", cfg.DBHost)
+
+	// --migrate applies pending migrations and exits, for running schema
+	// changes as a separate deploy step ahead of the server starting.
+	// --rollback reverts the most recently applied migration the same way.
+	if len(os.Args) > 1 {
+		switch os.Args[1] {
+		case "--migrate":
+			if err := db.Migrate(conn); err != nil {
+				log.Fatal("migration failed", "error", err)
+			}
+			log.Info("migrations applied")
+			return
+		case "--rollback":
+			if err := db.Rollback(conn); err != nil {
+				log.Fatal("rollback failed", "error", err)
+			}
+			log.Info("last migration rolled back")
+			return
+		}
+	}
+
+	if err := db.Migrate(conn); err != nil {
+		log.Fatal("could not migrate database", "error", err)
+	}
+
+	authMW := auth.NewMiddleware(cfg.JWTSecret)
+	taskStore := db.NewTaskStore(conn)
+	userStore := db.NewUserStore(conn)
+	policyStore := db.NewPolicyStore(conn)
+	jobStore := db.NewJobStore(conn)
+
+	engine := assignment.NewEngine(policyStore, taskStore)
+	scheduler := assignment.NewScheduler(engine, policyStore)
+	if err := scheduler.Start(); err != nil {
+		log.Fatal("could not start assignment scheduler", "error", err)
+	}
+	defer scheduler.Stop()
+
+	hub := jobs.NewHub()
+	pool := jobs.NewPool(jobStore, hub, jobWorkers, jobs.DefaultRunners(taskStore, engine))
+	defer pool.Shutdown()
+
+	taskHandler := tasks.NewHandler(taskStore, engine)
+	userHandler := users.NewHandler(userStore)
+	authHandler := authhandlers.NewHandler(userStore, authMW, cfg.RequireManager2FA)
+	policyHandler := policies.NewHandler(policyStore)
+	jobHandler := jobhandlers.NewHandler(jobStore, pool, hub)
+
+	mux := router.New(cfg, authMW, authHandler, taskHandler, userHandler, policyHandler, jobHandler)
+
+	server := &http.Server{Addr: ":" + cfg.Port, Handler: mux}
+
+	go func() {
+		log.Info("server starting", "port", cfg.Port)
+		if err := server.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+			log.Fatal("server stopped unexpectedly", "error", err)
+		}
+	}()
+
+	sigCh := make(chan os.Signal, 1)
+	signal.Notify(sigCh, syscall.SIGINT, syscall.SIGTERM)
+	<-sigCh
+
+	log.Info("shutting down")
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	if err := server.Shutdown(ctx); err != nil {
+		log.Error("server shutdown error", "error", err)
+	}
+}
@@ -0,0 +1,124 @@
+// Package assignment picks a technician for a task by evaluating
+// assignment policies, and periodically rebalances stale unassigned tasks.
+package assignment
+
+import (
+	"fmt"
+	"math/rand"
+	"sort"
+
+	"tech-management-backend/pkg/models"
+)
+
+// PolicyStore is the persistence boundary the engine needs for policies.
+type PolicyStore interface {
+	ListEnabled() ([]models.AssignmentPolicy, error)
+	AdvanceRoundRobin(policyID int) (int, error)
+}
+
+// TaskStore is the persistence boundary the engine needs for tasks.
+type TaskStore interface {
+	ActiveTaskCount(technicianID int) (int, error)
+	ListStalePendingUnassigned() ([]models.Task, error)
+	AssignTechnician(taskID, technicianID int) error
+}
+
+type Engine struct {
+	Policies PolicyStore
+	Tasks    TaskStore
+}
+
+func NewEngine(policies PolicyStore, tasks TaskStore) *Engine {
+	return &Engine{Policies: policies, Tasks: tasks}
+}
+
+// AssignTechnician evaluates enabled policies, highest priority first, and
+// returns the technician ID picked by the first matching policy's strategy.
+// It returns (nil, nil) if no enabled policy matches the task.
+func (e *Engine) AssignTechnician(task models.Task) (*int, error) {
+	policies, err := e.Policies.ListEnabled()
+	if err != nil {
+		return nil, err
+	}
+	sort.SliceStable(policies, func(i, j int) bool { return policies[i].Priority > policies[j].Priority })
+
+	for _, policy := range policies {
+		if !policy.Matches(task) || len(policy.TechnicianPool) == 0 {
+			continue
+		}
+		return e.pick(policy)
+	}
+	return nil, nil
+}
+
+// RebalanceStale assigns a technician, via policy's strategy, to every
+// PENDING task that still has no technician and matches policy's filters.
+func (e *Engine) RebalanceStale(policy models.AssignmentPolicy) error {
+	if len(policy.TechnicianPool) == 0 {
+		return nil
+	}
+
+	tasks, err := e.Tasks.ListStalePendingUnassigned()
+	if err != nil {
+		return err
+	}
+
+	for _, task := range tasks {
+		if !policy.Matches(task) {
+			continue
+		}
+		technicianID, err := e.pick(policy)
+		if err != nil {
+			return err
+		}
+		if technicianID == nil {
+			continue
+		}
+		if err := e.Tasks.AssignTechnician(task.ID, *technicianID); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (e *Engine) pick(policy models.AssignmentPolicy) (*int, error) {
+	switch policy.Strategy {
+	case models.StrategyRoundRobin:
+		cursor, err := e.Policies.AdvanceRoundRobin(policy.ID)
+		if err != nil {
+			return nil, err
+		}
+		id := policy.TechnicianPool[cursor%len(policy.TechnicianPool)]
+		return &id, nil
+
+	case models.StrategyLeastLoaded:
+		id, err := e.pickLeastLoaded(policy.TechnicianPool)
+		if err != nil {
+			return nil, err
+		}
+		return &id, nil
+
+	case models.StrategyRandom:
+		id := policy.TechnicianPool[rand.Intn(len(policy.TechnicianPool))]
+		return &id, nil
+
+	default:
+		return nil, fmt.Errorf("unknown assignment strategy %q", policy.Strategy)
+	}
+}
+
+func (e *Engine) pickLeastLoaded(pool []int) (int, error) {
+	best := pool[0]
+	bestCount := -1
+	for _, id := range pool {
+		count, err := e.Tasks.ActiveTaskCount(id)
+		if err != nil {
+			return 0, err
+		}
+		if bestCount == -1 || count < bestCount {
+			bestCount = count
+			best = id
+		}
+	}
+	return best, nil
+}
@@ -0,0 +1,147 @@
+package assignment
+
+import (
+	"testing"
+
+	"tech-management-backend/pkg/models"
+)
+
+type fakePolicyStore struct {
+	policies []models.AssignmentPolicy
+	cursors  map[int]int
+}
+
+func (f *fakePolicyStore) ListEnabled() ([]models.AssignmentPolicy, error) {
+	return f.policies, nil
+}
+
+func (f *fakePolicyStore) AdvanceRoundRobin(policyID int) (int, error) {
+	next := f.cursors[policyID]
+	f.cursors[policyID] = next + 1
+	return next, nil
+}
+
+type fakeTaskStore struct {
+	activeCounts map[int]int
+	stale        []models.Task
+	assigned     map[int]int
+}
+
+func (f *fakeTaskStore) ActiveTaskCount(technicianID int) (int, error) {
+	return f.activeCounts[technicianID], nil
+}
+
+func (f *fakeTaskStore) ListStalePendingUnassigned() ([]models.Task, error) {
+	return f.stale, nil
+}
+
+func (f *fakeTaskStore) AssignTechnician(taskID, technicianID int) error {
+	if f.assigned == nil {
+		f.assigned = map[int]int{}
+	}
+	f.assigned[taskID] = technicianID
+	return nil
+}
+
+func TestEngine_AssignTechnician_PicksHighestPriorityMatchingPolicy(t *testing.T) {
+	policies := &fakePolicyStore{
+		cursors: map[int]int{},
+		policies: []models.AssignmentPolicy{
+			{ID: 1, Priority: 1, Strategy: models.StrategyRoundRobin, TechnicianPool: []int{100}},
+			{ID: 2, Priority: 5, ClientFilter: "Acme", Strategy: models.StrategyRoundRobin, TechnicianPool: []int{200}},
+		},
+	}
+	engine := NewEngine(policies, &fakeTaskStore{})
+
+	id, err := engine.AssignTechnician(models.Task{Client: "Acme"})
+	if err != nil {
+		t.Fatalf("AssignTechnician: %v", err)
+	}
+	if id == nil || *id != 200 {
+		t.Fatalf("expected technician 200 from the higher-priority matching policy, got %v", id)
+	}
+}
+
+func TestEngine_AssignTechnician_NoMatch(t *testing.T) {
+	policies := &fakePolicyStore{
+		cursors: map[int]int{},
+		policies: []models.AssignmentPolicy{
+			{ID: 1, Priority: 1, ClientFilter: "Other", Strategy: models.StrategyRoundRobin, TechnicianPool: []int{100}},
+		},
+	}
+	engine := NewEngine(policies, &fakeTaskStore{})
+
+	id, err := engine.AssignTechnician(models.Task{Client: "Acme"})
+	if err != nil {
+		t.Fatalf("AssignTechnician: %v", err)
+	}
+	if id != nil {
+		t.Fatalf("expected no assignment, got %v", *id)
+	}
+}
+
+func TestEngine_AssignTechnician_RoundRobinCycles(t *testing.T) {
+	policies := &fakePolicyStore{
+		cursors: map[int]int{},
+		policies: []models.AssignmentPolicy{
+			{ID: 1, Strategy: models.StrategyRoundRobin, TechnicianPool: []int{1, 2, 3}},
+		},
+	}
+	engine := NewEngine(policies, &fakeTaskStore{})
+
+	var got []int
+	for i := 0; i < 4; i++ {
+		id, err := engine.AssignTechnician(models.Task{})
+		if err != nil {
+			t.Fatalf("AssignTechnician: %v", err)
+		}
+		got = append(got, *id)
+	}
+
+	want := []int{1, 2, 3, 1}
+	for i, id := range got {
+		if id != want[i] {
+			t.Fatalf("round 1, round-robin sequence %v, want %v", got, want)
+		}
+	}
+}
+
+func TestEngine_AssignTechnician_LeastLoadedPicksMinimum(t *testing.T) {
+	policies := &fakePolicyStore{
+		cursors: map[int]int{},
+		policies: []models.AssignmentPolicy{
+			{ID: 1, Strategy: models.StrategyLeastLoaded, TechnicianPool: []int{1, 2, 3}},
+		},
+	}
+	tasks := &fakeTaskStore{activeCounts: map[int]int{1: 5, 2: 1, 3: 4}}
+	engine := NewEngine(policies, tasks)
+
+	id, err := engine.AssignTechnician(models.Task{})
+	if err != nil {
+		t.Fatalf("AssignTechnician: %v", err)
+	}
+	if id == nil || *id != 2 {
+		t.Fatalf("expected least-loaded technician 2, got %v", id)
+	}
+}
+
+func TestEngine_RebalanceStale_AssignsMatchingTasks(t *testing.T) {
+	policies := &fakePolicyStore{cursors: map[int]int{}}
+	policy := models.AssignmentPolicy{ID: 1, ClientFilter: "Acme", Strategy: models.StrategyRoundRobin, TechnicianPool: []int{9}}
+	tasks := &fakeTaskStore{stale: []models.Task{
+		{ID: 10, Client: "Acme"},
+		{ID: 11, Client: "Other"},
+	}}
+	engine := NewEngine(policies, tasks)
+
+	if err := engine.RebalanceStale(policy); err != nil {
+		t.Fatalf("RebalanceStale: %v", err)
+	}
+
+	if tasks.assigned[10] != 9 {
+		t.Fatalf("expected task 10 assigned to technician 9, got %v", tasks.assigned)
+	}
+	if _, ok := tasks.assigned[11]; ok {
+		t.Fatalf("task 11 should not match the client filter, got %v", tasks.assigned)
+	}
+}
@@ -0,0 +1,59 @@
+package assignment
+
+import (
+	"fmt"
+
+	"github.com/robfig/cron/v3"
+
+	"tech-management-backend/pkg/log"
+	"tech-management-backend/pkg/models"
+)
+
+// Scheduler periodically re-runs RebalanceStale for every enabled policy
+// that has a cron schedule, so PENDING tasks left unassigned don't sit
+// idle forever.
+type Scheduler struct {
+	cron     *cron.Cron
+	engine   *Engine
+	policies PolicyStore
+}
+
+func NewScheduler(engine *Engine, policies PolicyStore) *Scheduler {
+	return &Scheduler{cron: cron.New(), engine: engine, policies: policies}
+}
+
+// Start loads the currently enabled, scheduled policies and begins running
+// them on their configured cron_str. It does not pick up policies created
+// after Start runs.
+func (s *Scheduler) Start() error {
+	policies, err := s.policies.ListEnabled()
+	if err != nil {
+		return err
+	}
+
+	for _, policy := range policies {
+		if policy.CronStr == "" {
+			continue
+		}
+		if err := s.schedule(policy); err != nil {
+			return fmt.Errorf("policy %q: %w", policy.Name, err)
+		}
+	}
+
+	s.cron.Start()
+	return nil
+}
+
+func (s *Scheduler) schedule(policy models.AssignmentPolicy) error {
+	_, err := s.cron.AddFunc(policy.CronStr, func() {
+		if err := s.engine.RebalanceStale(policy); err != nil {
+			log.Error("assignment: rebalance failed", "policy", policy.Name, "error", err)
+		}
+	})
+	return err
+}
+
+// Stop halts the scheduler, waiting for any in-flight rebalance to finish.
+func (s *Scheduler) Stop() {
+	<-s.cron.Stop().Done()
+}
@@ -0,0 +1,191 @@
+// Package auth implements JWT-based authentication and role-based
+// authorization for the task management API.
+package auth
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"golang.org/x/crypto/bcrypt"
+
+	"tech-management-backend/pkg/log"
+	"tech-management-backend/pkg/models"
+)
+
+// Role mirrors the user roles stored in the users table.
+type Role = models.UserRole
+
+const (
+	RoleManager    = models.RoleManager
+	RoleTechnician = models.RoleTechnician
+)
+
+// User is the subset of user data carried in a validated token and
+// made available to handlers via the request context.
+type User struct {
+	ID   int
+	Role Role
+}
+
+type claims struct {
+	UserID      int  `json:"sub"`
+	Role        Role `json:"role"`
+	OTPRequired bool `json:"otp_required,omitempty"`
+	jwt.RegisteredClaims
+}
+
+type contextKey struct{}
+
+var userContextKey = contextKey{}
+
+var (
+	// ErrMissingToken is returned when no bearer token is present on the request.
+	ErrMissingToken = errors.New("missing bearer token")
+	// ErrInvalidToken is returned for malformed, unsigned, or expired tokens.
+	ErrInvalidToken = errors.New("invalid or expired token")
+)
+
+// Middleware validates bearer tokens signed with a shared secret and
+// enforces role-based access on top of them.
+type Middleware struct {
+	secret []byte
+}
+
+// NewMiddleware builds a Middleware that signs and validates tokens with secret.
+func NewMiddleware(secret string) *Middleware {
+	return &Middleware{secret: []byte(secret)}
+}
+
+// GenerateToken issues an HS256 token for user, valid for ttl.
+func (m *Middleware) GenerateToken(user User, ttl time.Duration) (string, error) {
+	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims{
+		UserID: user.ID,
+		Role:   user.Role,
+		RegisteredClaims: jwt.RegisteredClaims{
+			ExpiresAt: jwt.NewNumericDate(time.Now().Add(ttl)),
+		},
+	})
+	return token.SignedString(m.secret)
+}
+
+// GenerateOTPPendingToken issues a short-lived token for a user whose
+// password checked out but who still owes a TOTP code. It carries
+// otp_required so RequireAuthenticated rejects it until it is exchanged via
+// the OTP challenge endpoint.
+func (m *Middleware) GenerateOTPPendingToken(user User, ttl time.Duration) (string, error) {
+	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims{
+		UserID:      user.ID,
+		Role:        user.Role,
+		OTPRequired: true,
+		RegisteredClaims: jwt.RegisteredClaims{
+			ExpiresAt: jwt.NewNumericDate(time.Now().Add(ttl)),
+		},
+	})
+	return token.SignedString(m.secret)
+}
+
+// ParseOTPPending validates a token issued by GenerateOTPPendingToken and
+// returns the user it was issued for, for use by the OTP challenge endpoint.
+func (m *Middleware) ParseOTPPending(tokenString string) (User, error) {
+	c, err := m.parseToken(tokenString)
+	if err != nil {
+		return User{}, err
+	}
+	if !c.OTPRequired {
+		return User{}, ErrInvalidToken
+	}
+	return User{ID: c.UserID, Role: c.Role}, nil
+}
+
+func (m *Middleware) parseToken(tokenString string) (*claims, error) {
+	c := &claims{}
+	token, err := jwt.ParseWithClaims(tokenString, c, func(t *jwt.Token) (interface{}, error) {
+		if _, ok := t.Method.(*jwt.SigningMethodHMAC); !ok {
+			return nil, ErrInvalidToken
+		}
+		return m.secret, nil
+	})
+	if err != nil || !token.Valid {
+		return nil, ErrInvalidToken
+	}
+	return c, nil
+}
+
+// RequireAuthenticated validates the Authorization bearer token and injects
+// the resolved User into the request context before calling next. It rejects
+// the request with 401 if the header is missing or the token is invalid or
+// expired.
+func (m *Middleware) RequireAuthenticated(next http.HandlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		header := r.Header.Get("Authorization")
+		tokenString := strings.TrimPrefix(header, "Bearer ")
+		if header == "" || tokenString == header {
+			http.Error(w, ErrMissingToken.Error(), http.StatusUnauthorized)
+			return
+		}
+
+		c, err := m.parseToken(tokenString)
+		if err != nil {
+			http.Error(w, ErrInvalidToken.Error(), http.StatusUnauthorized)
+			return
+		}
+		if c.OTPRequired {
+			http.Error(w, "OTP verification required", http.StatusUnauthorized)
+			return
+		}
+
+		ctx := context.WithValue(r.Context(), userContextKey, User{ID: c.UserID, Role: c.Role})
+		// log.Middleware, further up the chain, only sees the *http.Request it
+		// was originally called with — the new context this handler builds via
+		// WithContext never propagates back to it. Report the resolved user id
+		// through the mutable holder log.Middleware left behind instead.
+		if holder, ok := log.UserIDHolderFromContext(ctx); ok {
+			holder.Set(c.UserID)
+		}
+		next(w, r.WithContext(ctx))
+	}
+}
+
+// RequireRole is RequireAuthenticated plus a role check; use it for
+// endpoints restricted to a single role.
+func (m *Middleware) RequireRole(role Role, next http.HandlerFunc) http.HandlerFunc {
+	return m.RequireAuthenticated(func(w http.ResponseWriter, r *http.Request) {
+		user, ok := UserFromContext(r.Context())
+		if !ok || user.Role != role {
+			http.Error(w, "Forbidden", http.StatusForbidden)
+			return
+		}
+		next(w, r)
+	})
+}
+
+// UserFromContext returns the User injected by RequireAuthenticated/RequireRole.
+func UserFromContext(ctx context.Context) (User, bool) {
+	user, ok := ctx.Value(userContextKey).(User)
+	return user, ok
+}
+
+// WithUser returns a copy of ctx carrying user, as RequireAuthenticated would
+// leave it for a handler. Exported for handler tests that need to exercise
+// authorization logic without going through a real token.
+func WithUser(ctx context.Context, user User) context.Context {
+	return context.WithValue(ctx, userContextKey, user)
+}
+
+// HashPassword hashes a plaintext password for storage.
+func HashPassword(password string) (string, error) {
+	hashed, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost)
+	if err != nil {
+		return "", err
+	}
+	return string(hashed), nil
+}
+
+// ComparePassword reports whether password matches a hash produced by HashPassword.
+func ComparePassword(hash, password string) error {
+	return bcrypt.CompareHashAndPassword([]byte(hash), []byte(password))
+}
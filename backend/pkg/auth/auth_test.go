@@ -0,0 +1,105 @@
+package auth
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func okHandler(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusOK)
+}
+
+func TestRequireAuthenticated_MissingHeader(t *testing.T) {
+	m := NewMiddleware("secret")
+	req := httptest.NewRequest(http.MethodGet, "/api/tasks", nil)
+	rec := httptest.NewRecorder()
+
+	m.RequireAuthenticated(okHandler)(rec, req)
+
+	if rec.Code != http.StatusUnauthorized {
+		t.Fatalf("expected 401, got %d", rec.Code)
+	}
+}
+
+func TestRequireAuthenticated_ExpiredToken(t *testing.T) {
+	m := NewMiddleware("secret")
+	token, err := m.GenerateToken(User{ID: 1, Role: RoleTechnician}, -time.Minute)
+	if err != nil {
+		t.Fatalf("GenerateToken: %v", err)
+	}
+
+	req := httptest.NewRequest(http.MethodGet, "/api/tasks", nil)
+	req.Header.Set("Authorization", "Bearer "+token)
+	rec := httptest.NewRecorder()
+
+	m.RequireAuthenticated(okHandler)(rec, req)
+
+	if rec.Code != http.StatusUnauthorized {
+		t.Fatalf("expected 401 for expired token, got %d", rec.Code)
+	}
+}
+
+func TestRequireAuthenticated_ValidToken(t *testing.T) {
+	m := NewMiddleware("secret")
+	token, err := m.GenerateToken(User{ID: 7, Role: RoleManager}, time.Hour)
+	if err != nil {
+		t.Fatalf("GenerateToken: %v", err)
+	}
+
+	req := httptest.NewRequest(http.MethodGet, "/api/tasks", nil)
+	req.Header.Set("Authorization", "Bearer "+token)
+	rec := httptest.NewRecorder()
+
+	var gotUser User
+	var gotOK bool
+	m.RequireAuthenticated(func(w http.ResponseWriter, r *http.Request) {
+		gotUser, gotOK = UserFromContext(r.Context())
+		w.WriteHeader(http.StatusOK)
+	})(rec, req)
+
+	if rec.Code != http.StatusOK {
+		t.Fatalf("expected 200, got %d", rec.Code)
+	}
+	if !gotOK || gotUser.ID != 7 || gotUser.Role != RoleManager {
+		t.Fatalf("unexpected user in context: %+v (ok=%v)", gotUser, gotOK)
+	}
+}
+
+func TestRequireRole_WrongRole(t *testing.T) {
+	m := NewMiddleware("secret")
+	token, err := m.GenerateToken(User{ID: 2, Role: RoleTechnician}, time.Hour)
+	if err != nil {
+		t.Fatalf("GenerateToken: %v", err)
+	}
+
+	req := httptest.NewRequest(http.MethodPost, "/api/tasks", nil)
+	req.Header.Set("Authorization", "Bearer "+token)
+	rec := httptest.NewRecorder()
+
+	m.RequireRole(RoleManager, okHandler)(rec, req)
+
+	if rec.Code != http.StatusForbidden {
+		t.Fatalf("expected 403 for wrong role, got %d", rec.Code)
+	}
+}
+
+func TestRequireAuthenticated_WrongSecret(t *testing.T) {
+	issuer := NewMiddleware("secret-a")
+	verifier := NewMiddleware("secret-b")
+	token, err := issuer.GenerateToken(User{ID: 3, Role: RoleManager}, time.Hour)
+	if err != nil {
+		t.Fatalf("GenerateToken: %v", err)
+	}
+
+	req := httptest.NewRequest(http.MethodGet, "/api/tasks", nil)
+	req.Header.Set("Authorization", "Bearer "+token)
+	rec := httptest.NewRecorder()
+
+	verifier.RequireAuthenticated(okHandler)(rec, req)
+
+	if rec.Code != http.StatusUnauthorized {
+		t.Fatalf("expected 401 for token signed with a different secret, got %d", rec.Code)
+	}
+}
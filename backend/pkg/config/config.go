@@ -0,0 +1,54 @@
+// Package config loads runtime configuration from the environment.
+package config
+
+import (
+	"os"
+	"strconv"
+)
+
+type Config struct {
+	DBHost            string
+	DBPort            string
+	DBUser            string
+	DBPassword        string
+	DBName            string
+	JWTSecret         string
+	Port              string
+	AllowedOrigin     string
+	RequireManager2FA bool
+}
+
+// Load reads configuration from environment variables, applying the same
+// defaults the server has always used.
+func Load() Config {
+	return Config{
+		DBHost:            os.Getenv("DB_HOST"),
+		DBPort:            os.Getenv("DB_PORT"),
+		DBUser:            os.Getenv("DB_USER"),
+		DBPassword:        os.Getenv("DB_PASSWORD"),
+		DBName:            os.Getenv("DB_NAME"),
+		JWTSecret:         os.Getenv("JWT_SECRET"),
+		Port:              getEnv("PORT", "8080"),
+		AllowedOrigin:     getEnv("ALLOWED_ORIGIN", "*"),
+		RequireManager2FA: getEnvBool("REQUIRE_MANAGER_2FA", false),
+	}
+}
+
+func getEnv(key, fallback string) string {
+	if value, ok := os.LookupEnv(key); ok && value != "" {
+		return value
+	}
+	return fallback
+}
+
+func getEnvBool(key string, fallback bool) bool {
+	value, ok := os.LookupEnv(key)
+	if !ok {
+		return fallback
+	}
+	parsed, err := strconv.ParseBool(value)
+	if err != nil {
+		return fallback
+	}
+	return parsed
+}
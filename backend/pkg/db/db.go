@@ -0,0 +1,30 @@
+// Package db manages the PostgreSQL connection pool and schema, and
+// implements the store interfaces consumed by pkg/handlers.
+package db
+
+import (
+	"database/sql"
+	"fmt"
+
+	_ "github.com/lib/pq"
+
+	"tech-management-backend/pkg/config"
+)
+
+// Connect opens and pings a PostgreSQL connection pool built from cfg.
+func Connect(cfg config.Config) (*sql.DB, error) {
+	psqlInfo := fmt.Sprintf("host=%s port=%s user=%s password=%s dbname=%s sslmode=disable",
+		cfg.DBHost, cfg.DBPort, cfg.DBUser, cfg.DBPassword, cfg.DBName)
+
+	conn, err := sql.Open("postgres", psqlInfo)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := conn.Ping(); err != nil {
+		conn.Close()
+		return nil, fmt.Errorf("cannot connect to Postgres at %s: %w", cfg.DBHost, err)
+	}
+
+	return conn, nil
+}
@@ -0,0 +1,63 @@
+package db
+
+import (
+	"database/sql"
+
+	"tech-management-backend/pkg/models"
+)
+
+// JobStore is a PostgreSQL-backed implementation of jobs.Store.
+type JobStore struct {
+	DB *sql.DB
+}
+
+func NewJobStore(conn *sql.DB) *JobStore {
+	return &JobStore{DB: conn}
+}
+
+func (s *JobStore) Create(job models.Job) (models.Job, error) {
+	if len(job.Params) == 0 {
+		job.Params = []byte("{}")
+	}
+	err := s.DB.QueryRow(
+		`INSERT INTO job (type, status, params) VALUES ($1, $2, $3)
+		 RETURNING id, update_time`,
+		job.Type, job.Status, []byte(job.Params),
+	).Scan(&job.ID, &job.UpdateTime)
+	return job, err
+}
+
+func (s *JobStore) Get(id int) (models.Job, error) {
+	var job models.Job
+	var paramsJSON []byte
+	err := s.DB.QueryRow(
+		"SELECT id, type, status, params, start_time, update_time, log FROM job WHERE id = $1", id,
+	).Scan(&job.ID, &job.Type, &job.Status, &paramsJSON, &job.StartTime, &job.UpdateTime, &job.Log)
+	job.Params = paramsJSON
+	return job, err
+}
+
+func (s *JobStore) MarkRunning(id int) error {
+	_, err := s.DB.Exec(
+		"UPDATE job SET status = $1, start_time = NOW(), update_time = NOW() WHERE id = $2",
+		models.JobRunning, id,
+	)
+	return err
+}
+
+// AppendLog adds line as a new line in the job's log.
+func (s *JobStore) AppendLog(id int, line string) error {
+	_, err := s.DB.Exec(
+		"UPDATE job SET log = log || $1 || E'\n', update_time = NOW() WHERE id = $2",
+		line, id,
+	)
+	return err
+}
+
+func (s *JobStore) MarkFinished(id int, status models.JobStatus) error {
+	_, err := s.DB.Exec(
+		"UPDATE job SET status = $1, update_time = NOW() WHERE id = $2",
+		status, id,
+	)
+	return err
+}
@@ -0,0 +1,141 @@
+package db
+
+import (
+	"database/sql"
+	"embed"
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+)
+
+//go:embed migrations/*.sql
+var migrationFS embed.FS
+
+// migration is one versioned schema change, with both directions loaded
+// from migrations/<version>_<name>.{up,down}.sql.
+type migration struct {
+	Version int
+	Name    string
+	Up      string
+	Down    string
+}
+
+var migrationFilePattern = regexp.MustCompile(`^(\d+)_(.+)\.(up|down)\.sql$`)
+
+func loadMigrations() ([]migration, error) {
+	entries, err := migrationFS.ReadDir("migrations")
+	if err != nil {
+		return nil, err
+	}
+
+	byVersion := map[int]*migration{}
+	for _, entry := range entries {
+		matches := migrationFilePattern.FindStringSubmatch(entry.Name())
+		if matches == nil {
+			continue
+		}
+		version, err := strconv.Atoi(matches[1])
+		if err != nil {
+			return nil, err
+		}
+		content, err := migrationFS.ReadFile("migrations/" + entry.Name())
+		if err != nil {
+			return nil, err
+		}
+
+		m, ok := byVersion[version]
+		if !ok {
+			m = &migration{Version: version, Name: matches[2]}
+			byVersion[version] = m
+		}
+		if matches[3] == "up" {
+			m.Up = string(content)
+		} else {
+			m.Down = string(content)
+		}
+	}
+
+	migrations := make([]migration, 0, len(byVersion))
+	for _, m := range byVersion {
+		migrations = append(migrations, *m)
+	}
+	sort.Slice(migrations, func(i, j int) bool { return migrations[i].Version < migrations[j].Version })
+	return migrations, nil
+}
+
+func ensureMigrationsTable(conn *sql.DB) error {
+	_, err := conn.Exec(`CREATE TABLE IF NOT EXISTS schema_migrations (
+		version INTEGER PRIMARY KEY,
+		applied_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP
+	)`)
+	return err
+}
+
+// Migrate applies every migration not yet recorded in schema_migrations, in
+// ascending version order. It is safe to call on every boot: already-applied
+// migrations are skipped.
+func Migrate(conn *sql.DB) error {
+	if err := ensureMigrationsTable(conn); err != nil {
+		return err
+	}
+
+	migrations, err := loadMigrations()
+	if err != nil {
+		return err
+	}
+
+	for _, m := range migrations {
+		var applied bool
+		if err := conn.QueryRow(
+			"SELECT EXISTS(SELECT 1 FROM schema_migrations WHERE version = $1)", m.Version,
+		).Scan(&applied); err != nil {
+			return err
+		}
+		if applied {
+			continue
+		}
+
+		if _, err := conn.Exec(m.Up); err != nil {
+			return fmt.Errorf("migration %d_%s: %w", m.Version, m.Name, err)
+		}
+		if _, err := conn.Exec("INSERT INTO schema_migrations (version) VALUES ($1)", m.Version); err != nil {
+			return fmt.Errorf("migration %d_%s: recording version: %w", m.Version, m.Name, err)
+		}
+	}
+	return nil
+}
+
+// Rollback reverts the most recently applied migration. It is a no-op if no
+// migrations have been applied.
+func Rollback(conn *sql.DB) error {
+	if err := ensureMigrationsTable(conn); err != nil {
+		return err
+	}
+
+	var version int
+	err := conn.QueryRow("SELECT version FROM schema_migrations ORDER BY version DESC LIMIT 1").Scan(&version)
+	if err == sql.ErrNoRows {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	migrations, err := loadMigrations()
+	if err != nil {
+		return err
+	}
+
+	for _, m := range migrations {
+		if m.Version != version {
+			continue
+		}
+		if _, err := conn.Exec(m.Down); err != nil {
+			return fmt.Errorf("rollback %d_%s: %w", m.Version, m.Name, err)
+		}
+		_, err := conn.Exec("DELETE FROM schema_migrations WHERE version = $1", version)
+		return err
+	}
+	return fmt.Errorf("no migration file found for applied version %d", version)
+}
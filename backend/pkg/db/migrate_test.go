@@ -0,0 +1,50 @@
+package db
+
+import "testing"
+
+func TestLoadMigrations_OrderedWithUpAndDown(t *testing.T) {
+	migrations, err := loadMigrations()
+	if err != nil {
+		t.Fatalf("loadMigrations: %v", err)
+	}
+
+	if len(migrations) == 0 {
+		t.Fatal("expected at least one migration")
+	}
+
+	for i, m := range migrations {
+		if m.Up == "" {
+			t.Errorf("migration %d_%s: missing up.sql", m.Version, m.Name)
+		}
+		if m.Down == "" {
+			t.Errorf("migration %d_%s: missing down.sql", m.Version, m.Name)
+		}
+		if i > 0 && migrations[i-1].Version >= m.Version {
+			t.Errorf("migrations out of order: %d came before %d", migrations[i-1].Version, m.Version)
+		}
+	}
+}
+
+func TestLoadMigrations_FirstMigrationCreatesUsersAndTasks(t *testing.T) {
+	migrations, err := loadMigrations()
+	if err != nil {
+		t.Fatalf("loadMigrations: %v", err)
+	}
+
+	first := migrations[0]
+	if first.Version != 1 {
+		t.Fatalf("expected first migration to be version 1, got %d", first.Version)
+	}
+	if !contains(first.Up, "CREATE TABLE IF NOT EXISTS users") || !contains(first.Up, "CREATE TABLE IF NOT EXISTS tasks") {
+		t.Fatalf("expected migration 1 to create users and tasks, got:\n%s", first.Up)
+	}
+}
+
+func contains(s, substr string) bool {
+	for i := 0; i+len(substr) <= len(s); i++ {
+		if s[i:i+len(substr)] == substr {
+			return true
+		}
+	}
+	return false
+}
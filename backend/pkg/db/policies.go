@@ -0,0 +1,129 @@
+package db
+
+import (
+	"database/sql"
+	"encoding/json"
+
+	"tech-management-backend/pkg/models"
+)
+
+// PolicyStore is a PostgreSQL-backed implementation of handlers/policies.Store
+// and assignment.PolicyStore.
+type PolicyStore struct {
+	DB *sql.DB
+}
+
+func NewPolicyStore(conn *sql.DB) *PolicyStore {
+	return &PolicyStore{DB: conn}
+}
+
+const policyColumns = "id, name, enabled, priority, priority_filter, client_filter, technician_pool, strategy, cron_str, round_robin_cursor, created_at, updated_at"
+
+func scanPolicy(scan func(dest ...interface{}) error) (models.AssignmentPolicy, error) {
+	var p models.AssignmentPolicy
+	var poolJSON []byte
+	err := scan(&p.ID, &p.Name, &p.Enabled, &p.Priority, &p.PriorityFilter, &p.ClientFilter,
+		&poolJSON, &p.Strategy, &p.CronStr, &p.RoundRobinCursor, &p.CreatedAt, &p.UpdatedAt)
+	if err != nil {
+		return models.AssignmentPolicy{}, err
+	}
+	if len(poolJSON) > 0 {
+		if err := json.Unmarshal(poolJSON, &p.TechnicianPool); err != nil {
+			return models.AssignmentPolicy{}, err
+		}
+	}
+	return p, nil
+}
+
+func (s *PolicyStore) List() ([]models.AssignmentPolicy, error) {
+	rows, err := s.DB.Query("SELECT " + policyColumns + " FROM assignment_policy ORDER BY priority DESC")
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	policies := []models.AssignmentPolicy{}
+	for rows.Next() {
+		p, err := scanPolicy(rows.Scan)
+		if err != nil {
+			continue
+		}
+		policies = append(policies, p)
+	}
+	return policies, nil
+}
+
+// ListEnabled returns enabled policies ordered highest priority first.
+func (s *PolicyStore) ListEnabled() ([]models.AssignmentPolicy, error) {
+	rows, err := s.DB.Query("SELECT " + policyColumns + " FROM assignment_policy WHERE enabled = true ORDER BY priority DESC")
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	policies := []models.AssignmentPolicy{}
+	for rows.Next() {
+		p, err := scanPolicy(rows.Scan)
+		if err != nil {
+			continue
+		}
+		policies = append(policies, p)
+	}
+	return policies, nil
+}
+
+func (s *PolicyStore) Get(id int) (models.AssignmentPolicy, error) {
+	row := s.DB.QueryRow("SELECT "+policyColumns+" FROM assignment_policy WHERE id = $1", id)
+	return scanPolicy(row.Scan)
+}
+
+func (s *PolicyStore) Create(p models.AssignmentPolicy) (models.AssignmentPolicy, error) {
+	poolJSON, err := json.Marshal(p.TechnicianPool)
+	if err != nil {
+		return models.AssignmentPolicy{}, err
+	}
+
+	err = s.DB.QueryRow(
+		`INSERT INTO assignment_policy (name, enabled, priority, priority_filter, client_filter, technician_pool, strategy, cron_str)
+		 VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+		 RETURNING id, created_at, updated_at`,
+		p.Name, p.Enabled, p.Priority, p.PriorityFilter, p.ClientFilter, poolJSON, p.Strategy, p.CronStr,
+	).Scan(&p.ID, &p.CreatedAt, &p.UpdatedAt)
+	return p, err
+}
+
+func (s *PolicyStore) Update(id int, p models.AssignmentPolicy) error {
+	poolJSON, err := json.Marshal(p.TechnicianPool)
+	if err != nil {
+		return err
+	}
+
+	_, err = s.DB.Exec(
+		`UPDATE assignment_policy
+		 SET name = $1, enabled = $2, priority = $3, priority_filter = $4, client_filter = $5,
+		     technician_pool = $6, strategy = $7, cron_str = $8, updated_at = NOW()
+		 WHERE id = $9`,
+		p.Name, p.Enabled, p.Priority, p.PriorityFilter, p.ClientFilter, poolJSON, p.Strategy, p.CronStr, id,
+	)
+	return err
+}
+
+func (s *PolicyStore) Delete(id int) error {
+	_, err := s.DB.Exec("DELETE FROM assignment_policy WHERE id = $1", id)
+	return err
+}
+
+// AdvanceRoundRobin atomically increments and persists a policy's
+// round-robin cursor, returning its value before the increment, so
+// ROUND_ROBIN assignment survives restarts.
+func (s *PolicyStore) AdvanceRoundRobin(policyID int) (int, error) {
+	var previous int
+	err := s.DB.QueryRow(
+		`UPDATE assignment_policy
+		 SET round_robin_cursor = round_robin_cursor + 1
+		 WHERE id = $1
+		 RETURNING round_robin_cursor - 1`,
+		policyID,
+	).Scan(&previous)
+	return previous, err
+}
@@ -0,0 +1,144 @@
+package db
+
+import (
+	"database/sql"
+
+	"tech-management-backend/pkg/models"
+)
+
+// TaskStore is a PostgreSQL-backed implementation of handlers/tasks.Store.
+type TaskStore struct {
+	DB *sql.DB
+}
+
+func NewTaskStore(conn *sql.DB) *TaskStore {
+	return &TaskStore{DB: conn}
+}
+
+const taskColumns = "id, title, description, status, priority, technician_id, client, notes, assignee_history, created_at, updated_at"
+
+func scanTask(scan func(dest ...interface{}) error) (models.Task, error) {
+	var t models.Task
+	var historyJSON []byte
+	err := scan(&t.ID, &t.Title, &t.Description, &t.Status, &t.Priority, &t.TechnicianID, &t.Client,
+		&t.Notes, &historyJSON, &t.CreatedAt, &t.UpdatedAt)
+	if err != nil {
+		return models.Task{}, err
+	}
+	t.AssigneeHistory = historyJSON
+	return t, nil
+}
+
+func (s *TaskStore) List() ([]models.Task, error) {
+	rows, err := s.DB.Query("SELECT " + taskColumns + " FROM tasks ORDER BY created_at DESC")
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	tasks := []models.Task{}
+	for rows.Next() {
+		t, err := scanTask(rows.Scan)
+		if err != nil {
+			continue
+		}
+		tasks = append(tasks, t)
+	}
+	return tasks, nil
+}
+
+func (s *TaskStore) Create(t models.Task) (models.Task, error) {
+	if len(t.AssigneeHistory) == 0 {
+		t.AssigneeHistory = []byte("[]")
+	}
+	err := s.DB.QueryRow(
+		`INSERT INTO tasks (title, description, status, priority, technician_id, client, notes, assignee_history)
+		 VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+		 RETURNING id, created_at, updated_at`,
+		t.Title, t.Description, t.Status, t.Priority, t.TechnicianID, t.Client, t.Notes, []byte(t.AssigneeHistory),
+	).Scan(&t.ID, &t.CreatedAt, &t.UpdatedAt)
+	return t, err
+}
+
+// Update overwrites the task at id, returning sql.ErrNoRows if it does not exist.
+func (s *TaskStore) Update(id int, t models.Task) error {
+	if len(t.AssigneeHistory) == 0 {
+		t.AssigneeHistory = []byte("[]")
+	}
+	result, err := s.DB.Exec(
+		`UPDATE tasks
+		 SET title = $1, description = $2, status = $3, priority = $4, technician_id = $5, client = $6,
+		     notes = $7, assignee_history = $8, updated_at = NOW()
+		 WHERE id = $9`,
+		t.Title, t.Description, t.Status, t.Priority, t.TechnicianID, t.Client, t.Notes, []byte(t.AssigneeHistory), id,
+	)
+	if err != nil {
+		return err
+	}
+	rowsAffected, _ := result.RowsAffected()
+	if rowsAffected == 0 {
+		return sql.ErrNoRows
+	}
+	return nil
+}
+
+func (s *TaskStore) Delete(id int) error {
+	_, err := s.DB.Exec("DELETE FROM tasks WHERE id = $1", id)
+	return err
+}
+
+// TechnicianIDFor returns the technician_id assigned to the task, or
+// sql.ErrNoRows if the task does not exist.
+func (s *TaskStore) TechnicianIDFor(id int) (*int, error) {
+	var technicianID *int
+	err := s.DB.QueryRow("SELECT technician_id FROM tasks WHERE id = $1", id).Scan(&technicianID)
+	if err != nil {
+		return nil, err
+	}
+	return technicianID, nil
+}
+
+// staleAfter is how long a PENDING, unassigned task sits before the
+// assignment scheduler will try to dispatch it to a technician.
+const staleAfter = "15 minutes"
+
+// ActiveTaskCount returns how many non-DONE tasks are assigned to a
+// technician, used by the LEAST_LOADED assignment strategy.
+func (s *TaskStore) ActiveTaskCount(technicianID int) (int, error) {
+	var count int
+	err := s.DB.QueryRow(
+		"SELECT COUNT(*) FROM tasks WHERE technician_id = $1 AND status != 'DONE'", technicianID,
+	).Scan(&count)
+	return count, err
+}
+
+// ListStalePendingUnassigned returns PENDING tasks with no technician that
+// have sat unassigned for longer than staleAfter.
+func (s *TaskStore) ListStalePendingUnassigned() ([]models.Task, error) {
+	rows, err := s.DB.Query(
+		`SELECT ` + taskColumns + `
+		 FROM tasks
+		 WHERE status = 'PENDING' AND technician_id IS NULL AND created_at < NOW() - INTERVAL '` + staleAfter + `'`,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	tasks := []models.Task{}
+	for rows.Next() {
+		t, err := scanTask(rows.Scan)
+		if err != nil {
+			continue
+		}
+		tasks = append(tasks, t)
+	}
+	return tasks, nil
+}
+
+// AssignTechnician sets a task's technician_id, used by the assignment
+// scheduler to dispatch stale unassigned tasks.
+func (s *TaskStore) AssignTechnician(taskID, technicianID int) error {
+	_, err := s.DB.Exec("UPDATE tasks SET technician_id = $1 WHERE id = $2", technicianID, taskID)
+	return err
+}
@@ -0,0 +1,93 @@
+package db
+
+import (
+	"database/sql"
+
+	"tech-management-backend/pkg/models"
+)
+
+// UserStore is a PostgreSQL-backed implementation of the store interfaces
+// consumed by handlers/auth and handlers/users.
+type UserStore struct {
+	DB *sql.DB
+}
+
+func NewUserStore(conn *sql.DB) *UserStore {
+	return &UserStore{DB: conn}
+}
+
+func (s *UserStore) GetByEmail(email string) (models.User, error) {
+	var u models.User
+	err := s.DB.QueryRow("SELECT id, email, password, name, role, otp_secret, otp_verified, created_at FROM users WHERE email = $1", email).
+		Scan(&u.ID, &u.Email, &u.Password, &u.Name, &u.Role, &u.OTPSecret, &u.OTPVerified, &u.CreatedAt)
+	return u, err
+}
+
+func (s *UserStore) GetByID(id int) (models.User, error) {
+	var u models.User
+	err := s.DB.QueryRow("SELECT id, email, name, role, otp_secret, otp_verified, created_at FROM users WHERE id = $1", id).
+		Scan(&u.ID, &u.Email, &u.Name, &u.Role, &u.OTPSecret, &u.OTPVerified, &u.CreatedAt)
+	return u, err
+}
+
+func (s *UserStore) Create(u models.User) (models.User, error) {
+	err := s.DB.QueryRow(
+		"INSERT INTO users (email, password, name, role) VALUES ($1, $2, $3, $4) RETURNING id, created_at",
+		u.Email, u.Password, u.Name, u.Role,
+	).Scan(&u.ID, &u.CreatedAt)
+	return u, err
+}
+
+func (s *UserStore) Update(id int, u models.User) error {
+	_, err := s.DB.Exec(
+		"UPDATE users SET name = $1, email = $2, role = $3 WHERE id = $4",
+		u.Name, u.Email, u.Role, id,
+	)
+	return err
+}
+
+func (s *UserStore) UpdateRole(id int, role models.UserRole) error {
+	_, err := s.DB.Exec("UPDATE users SET role = $1 WHERE id = $2", role, id)
+	return err
+}
+
+// SetOTPSecret stores a newly-generated TOTP secret and resets otp_verified
+// until the first code is confirmed.
+func (s *UserStore) SetOTPSecret(id int, secret string) error {
+	_, err := s.DB.Exec("UPDATE users SET otp_secret = $1, otp_verified = false WHERE id = $2", secret, id)
+	return err
+}
+
+func (s *UserStore) SetOTPVerified(id int, verified bool) error {
+	_, err := s.DB.Exec("UPDATE users SET otp_verified = $1 WHERE id = $2", verified, id)
+	return err
+}
+
+// DisableOTP removes a user's TOTP enrollment entirely.
+func (s *UserStore) DisableOTP(id int) error {
+	_, err := s.DB.Exec("UPDATE users SET otp_secret = '', otp_verified = false WHERE id = $1", id)
+	return err
+}
+
+func (s *UserStore) Delete(id int) error {
+	_, err := s.DB.Exec("DELETE FROM users WHERE id = $1", id)
+	return err
+}
+
+func (s *UserStore) ListByRole(role models.UserRole) ([]models.User, error) {
+	rows, err := s.DB.Query("SELECT id, name, email, role FROM users WHERE role = $1", role)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var users []models.User
+	for rows.Next() {
+		var u models.User
+		if err := rows.Scan(&u.ID, &u.Name, &u.Email, &u.Role); err != nil {
+			continue
+		}
+		users = append(users, u)
+	}
+	return users, nil
+}
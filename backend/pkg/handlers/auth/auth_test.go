@@ -0,0 +1,117 @@
+package authhandlers
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"tech-management-backend/pkg/auth"
+	"tech-management-backend/pkg/models"
+)
+
+type fakeStore struct {
+	byEmail map[string]models.User
+	byID    map[int]models.User
+}
+
+func (f *fakeStore) GetByEmail(email string) (models.User, error) {
+	u, ok := f.byEmail[email]
+	if !ok {
+		return models.User{}, http.ErrNoCookie
+	}
+	return u, nil
+}
+
+func (f *fakeStore) GetByID(id int) (models.User, error) {
+	u, ok := f.byID[id]
+	if !ok {
+		return models.User{}, http.ErrNoCookie
+	}
+	return u, nil
+}
+
+func (f *fakeStore) Create(u models.User) (models.User, error) {
+	u.ID = len(f.byID) + 1
+	f.byID[u.ID] = u
+	return u, nil
+}
+
+func (f *fakeStore) SetOTPSecret(id int, secret string) error {
+	u := f.byID[id]
+	u.OTPSecret = secret
+	u.OTPVerified = false
+	f.byID[id] = u
+	return nil
+}
+
+func (f *fakeStore) SetOTPVerified(id int, verified bool) error {
+	u := f.byID[id]
+	u.OTPVerified = verified
+	f.byID[id] = u
+	return nil
+}
+
+func (f *fakeStore) DisableOTP(id int) error {
+	u := f.byID[id]
+	u.OTPSecret = ""
+	u.OTPVerified = false
+	f.byID[id] = u
+	return nil
+}
+
+func TestHandler_Login_WrongPassword(t *testing.T) {
+	hashed, err := auth.HashPassword("correct-horse")
+	if err != nil {
+		t.Fatalf("HashPassword: %v", err)
+	}
+	store := &fakeStore{byEmail: map[string]models.User{
+		"tech@example.com": {ID: 1, Email: "tech@example.com", Password: hashed, Role: models.RoleTechnician},
+	}}
+	h := NewHandler(store, auth.NewMiddleware("secret"), false)
+
+	req := httptest.NewRequest(http.MethodPost, "/api/auth/login", strings.NewReader(`{"email":"tech@example.com","password":"wrong"}`))
+	rec := httptest.NewRecorder()
+
+	h.Login(rec, req)
+
+	if rec.Code != http.StatusUnauthorized {
+		t.Fatalf("expected 401, got %d", rec.Code)
+	}
+}
+
+func TestHandler_Login_Success(t *testing.T) {
+	hashed, err := auth.HashPassword("correct-horse")
+	if err != nil {
+		t.Fatalf("HashPassword: %v", err)
+	}
+	store := &fakeStore{byEmail: map[string]models.User{
+		"tech@example.com": {ID: 1, Email: "tech@example.com", Password: hashed, Role: models.RoleTechnician},
+	}}
+	h := NewHandler(store, auth.NewMiddleware("secret"), false)
+
+	req := httptest.NewRequest(http.MethodPost, "/api/auth/login", strings.NewReader(`{"email":"tech@example.com","password":"correct-horse"}`))
+	rec := httptest.NewRecorder()
+
+	h.Login(rec, req)
+
+	if rec.Code != http.StatusOK {
+		t.Fatalf("expected 200, got %d: %s", rec.Code, rec.Body.String())
+	}
+	if !strings.Contains(rec.Body.String(), `"token"`) {
+		t.Fatalf("expected token in response, got %s", rec.Body.String())
+	}
+}
+
+func TestHandler_Me_Unauthenticated(t *testing.T) {
+	h := NewHandler(&fakeStore{byID: map[int]models.User{}}, auth.NewMiddleware("secret"), false)
+
+	req := httptest.NewRequest(http.MethodGet, "/api/auth/me", nil)
+	rec := httptest.NewRecorder()
+
+	h.Me(rec, req)
+
+	if rec.Code != http.StatusUnauthorized {
+		t.Fatalf("expected 401, got %d", rec.Code)
+	}
+}
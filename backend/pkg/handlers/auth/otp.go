@@ -0,0 +1,172 @@
+package authhandlers
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"image/png"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/pquerna/otp"
+	"github.com/pquerna/otp/totp"
+
+	"tech-management-backend/pkg/auth"
+)
+
+// otpTokenTTL is how long an intermediate OTP-pending token (issued by
+// Login when 2FA is required) stays valid before the user must log in again.
+const otpTokenTTL = 5 * time.Minute
+
+type otpCodeRequest struct {
+	Code string `json:"code"`
+}
+
+// Enroll generates a new TOTP secret for the authenticated user and returns
+// its provisioning URI and a base64-encoded QR code PNG to scan into an
+// authenticator app. The secret takes effect once Verify confirms it, except
+// for a manager under RequireManager2FA, whose logins are challenged against
+// it from the next login on.
+func (h *Handler) Enroll(w http.ResponseWriter, r *http.Request) {
+	authUser, ok := auth.UserFromContext(r.Context())
+	if !ok {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	user, err := h.Store.GetByID(authUser.ID)
+	if err != nil {
+		http.Error(w, "User not found", http.StatusNotFound)
+		return
+	}
+
+	key, err := totp.Generate(totp.GenerateOpts{
+		Issuer:      "Task Management",
+		AccountName: user.Email,
+	})
+	if err != nil {
+		http.Error(w, "Could not generate OTP secret", http.StatusInternalServerError)
+		return
+	}
+
+	if err := h.Store.SetOTPSecret(user.ID, key.Secret()); err != nil {
+		http.Error(w, "Could not save OTP secret", http.StatusInternalServerError)
+		return
+	}
+
+	qrPNG, err := qrCodePNG(key)
+	if err != nil {
+		http.Error(w, "Could not generate QR code", http.StatusInternalServerError)
+		return
+	}
+
+	json.NewEncoder(w).Encode(map[string]string{
+		"provisioningUri": key.URL(),
+		"qrCodePng":       base64.StdEncoding.EncodeToString(qrPNG),
+	})
+}
+
+// Verify confirms the first code generated from an enrolled secret and
+// flips otp_verified, enforcing 2FA on the account from then on.
+func (h *Handler) Verify(w http.ResponseWriter, r *http.Request) {
+	authUser, ok := auth.UserFromContext(r.Context())
+	if !ok {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	var req otpCodeRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, "Invalid request", http.StatusBadRequest)
+		return
+	}
+
+	user, err := h.Store.GetByID(authUser.ID)
+	if err != nil {
+		http.Error(w, "User not found", http.StatusNotFound)
+		return
+	}
+	if user.OTPSecret == "" {
+		http.Error(w, "OTP not enrolled", http.StatusBadRequest)
+		return
+	}
+	if !totp.Validate(req.Code, user.OTPSecret) {
+		http.Error(w, "Invalid code", http.StatusUnauthorized)
+		return
+	}
+
+	if err := h.Store.SetOTPVerified(authUser.ID, true); err != nil {
+		http.Error(w, "Could not verify OTP", http.StatusInternalServerError)
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+}
+
+// Disable removes OTP enrollment from the authenticated user's account.
+func (h *Handler) Disable(w http.ResponseWriter, r *http.Request) {
+	authUser, ok := auth.UserFromContext(r.Context())
+	if !ok {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	if err := h.Store.DisableOTP(authUser.ID); err != nil {
+		http.Error(w, "Could not disable OTP", http.StatusInternalServerError)
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+}
+
+// Challenge exchanges an OTP-pending intermediate token (issued by Login)
+// plus a valid TOTP code for a full-privilege session token.
+func (h *Handler) Challenge(w http.ResponseWriter, r *http.Request) {
+	header := r.Header.Get("Authorization")
+	tokenString := strings.TrimPrefix(header, "Bearer ")
+	if header == "" || tokenString == header {
+		http.Error(w, "Missing bearer token", http.StatusUnauthorized)
+		return
+	}
+
+	pending, err := h.Auth.ParseOTPPending(tokenString)
+	if err != nil {
+		http.Error(w, "Invalid or expired token", http.StatusUnauthorized)
+		return
+	}
+
+	var req otpCodeRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, "Invalid request", http.StatusBadRequest)
+		return
+	}
+
+	user, err := h.Store.GetByID(pending.ID)
+	if err != nil {
+		http.Error(w, "User not found", http.StatusNotFound)
+		return
+	}
+	if !totp.Validate(req.Code, user.OTPSecret) {
+		http.Error(w, "Invalid code", http.StatusUnauthorized)
+		return
+	}
+
+	token, err := h.Auth.GenerateToken(pending, 24*time.Hour)
+	if err != nil {
+		http.Error(w, "Could not sign token", http.StatusInternalServerError)
+		return
+	}
+
+	json.NewEncoder(w).Encode(map[string]string{"token": token})
+}
+
+func qrCodePNG(key *otp.Key) ([]byte, error) {
+	img, err := key.Image(200, 200)
+	if err != nil {
+		return nil, err
+	}
+	var buf bytes.Buffer
+	if err := png.Encode(&buf, img); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
@@ -0,0 +1,177 @@
+package authhandlers
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/pquerna/otp/totp"
+
+	"tech-management-backend/pkg/auth"
+	"tech-management-backend/pkg/models"
+)
+
+func TestHandler_EnrollThenVerify(t *testing.T) {
+	store := &fakeStore{byID: map[int]models.User{1: {ID: 1, Email: "mgr@example.com", Role: models.RoleManager}}}
+	h := NewHandler(store, auth.NewMiddleware("secret"), false)
+
+	req := httptest.NewRequest(http.MethodPost, "/api/auth/otp/enroll", nil)
+	req = req.WithContext(auth.WithUser(req.Context(), auth.User{ID: 1, Role: auth.RoleManager}))
+	rec := httptest.NewRecorder()
+
+	h.Enroll(rec, req)
+
+	if rec.Code != http.StatusOK {
+		t.Fatalf("expected 200, got %d: %s", rec.Code, rec.Body.String())
+	}
+	var resp struct {
+		ProvisioningURI string `json:"provisioningUri"`
+		QRCodePNG       string `json:"qrCodePng"`
+	}
+	if err := json.NewDecoder(rec.Body).Decode(&resp); err != nil {
+		t.Fatalf("decode response: %v", err)
+	}
+	if resp.ProvisioningURI == "" || resp.QRCodePNG == "" {
+		t.Fatalf("expected provisioning URI and QR code in response, got %+v", resp)
+	}
+	if store.byID[1].OTPSecret == "" {
+		t.Fatal("expected OTP secret to be saved")
+	}
+
+	code, err := totp.GenerateCode(store.byID[1].OTPSecret, time.Now())
+	if err != nil {
+		t.Fatalf("GenerateCode: %v", err)
+	}
+
+	verifyReq := httptest.NewRequest(http.MethodPost, "/api/auth/otp/verify", strings.NewReader(`{"code":"`+code+`"}`))
+	verifyReq = verifyReq.WithContext(auth.WithUser(verifyReq.Context(), auth.User{ID: 1, Role: auth.RoleManager}))
+	verifyRec := httptest.NewRecorder()
+
+	h.Verify(verifyRec, verifyReq)
+
+	if verifyRec.Code != http.StatusOK {
+		t.Fatalf("expected 200, got %d: %s", verifyRec.Code, verifyRec.Body.String())
+	}
+	if !store.byID[1].OTPVerified {
+		t.Fatal("expected OTPVerified to be true after a valid code")
+	}
+}
+
+func TestHandler_Verify_WrongCode(t *testing.T) {
+	store := &fakeStore{byID: map[int]models.User{1: {ID: 1, OTPSecret: "JBSWY3DPEHPK3PXP"}}}
+	h := NewHandler(store, auth.NewMiddleware("secret"), false)
+
+	req := httptest.NewRequest(http.MethodPost, "/api/auth/otp/verify", strings.NewReader(`{"code":"000000"}`))
+	req = req.WithContext(auth.WithUser(req.Context(), auth.User{ID: 1}))
+	rec := httptest.NewRecorder()
+
+	h.Verify(rec, req)
+
+	if rec.Code != http.StatusUnauthorized {
+		t.Fatalf("expected 401, got %d", rec.Code)
+	}
+}
+
+func TestHandler_Login_RequiresOTPWhenVerified(t *testing.T) {
+	hashed, err := auth.HashPassword("correct-horse")
+	if err != nil {
+		t.Fatalf("HashPassword: %v", err)
+	}
+	store := &fakeStore{byEmail: map[string]models.User{
+		"mgr@example.com": {ID: 1, Email: "mgr@example.com", Password: hashed, Role: models.RoleManager, OTPVerified: true},
+	}}
+	h := NewHandler(store, auth.NewMiddleware("secret"), false)
+
+	req := httptest.NewRequest(http.MethodPost, "/api/auth/login", strings.NewReader(`{"email":"mgr@example.com","password":"correct-horse"}`))
+	rec := httptest.NewRecorder()
+
+	h.Login(rec, req)
+
+	if rec.Code != http.StatusOK {
+		t.Fatalf("expected 200, got %d: %s", rec.Code, rec.Body.String())
+	}
+	if !strings.Contains(rec.Body.String(), `"otpRequired":true`) {
+		t.Fatalf("expected otpRequired in response, got %s", rec.Body.String())
+	}
+}
+
+func TestHandler_Login_UnenrolledManagerGetsFullTokenDespiteRequireManager2FA(t *testing.T) {
+	hashed, err := auth.HashPassword("correct-horse")
+	if err != nil {
+		t.Fatalf("HashPassword: %v", err)
+	}
+	store := &fakeStore{byEmail: map[string]models.User{
+		"mgr@example.com": {ID: 1, Email: "mgr@example.com", Password: hashed, Role: models.RoleManager},
+	}}
+	h := NewHandler(store, auth.NewMiddleware("secret"), true)
+
+	req := httptest.NewRequest(http.MethodPost, "/api/auth/login", strings.NewReader(`{"email":"mgr@example.com","password":"correct-horse"}`))
+	rec := httptest.NewRecorder()
+
+	h.Login(rec, req)
+
+	if rec.Code != http.StatusOK {
+		t.Fatalf("expected 200, got %d: %s", rec.Code, rec.Body.String())
+	}
+	if strings.Contains(rec.Body.String(), `"otpRequired"`) {
+		t.Fatalf("expected a full token since the manager has no OTP secret to challenge yet, got %s", rec.Body.String())
+	}
+	if !strings.Contains(rec.Body.String(), `"token"`) {
+		t.Fatalf("expected token in response, got %s", rec.Body.String())
+	}
+}
+
+func TestHandler_Login_EnrolledManagerRequiresOTPWhenRequireManager2FA(t *testing.T) {
+	hashed, err := auth.HashPassword("correct-horse")
+	if err != nil {
+		t.Fatalf("HashPassword: %v", err)
+	}
+	store := &fakeStore{byEmail: map[string]models.User{
+		"mgr@example.com": {ID: 1, Email: "mgr@example.com", Password: hashed, Role: models.RoleManager, OTPSecret: "JBSWY3DPEHPK3PXP"},
+	}}
+	h := NewHandler(store, auth.NewMiddleware("secret"), true)
+
+	req := httptest.NewRequest(http.MethodPost, "/api/auth/login", strings.NewReader(`{"email":"mgr@example.com","password":"correct-horse"}`))
+	rec := httptest.NewRecorder()
+
+	h.Login(rec, req)
+
+	if rec.Code != http.StatusOK {
+		t.Fatalf("expected 200, got %d: %s", rec.Code, rec.Body.String())
+	}
+	if !strings.Contains(rec.Body.String(), `"otpRequired":true`) {
+		t.Fatalf("expected otpRequired once a secret is enrolled, got %s", rec.Body.String())
+	}
+}
+
+func TestHandler_Challenge_ExchangesPendingTokenForFullToken(t *testing.T) {
+	secret := "JBSWY3DPEHPK3PXP"
+	store := &fakeStore{byID: map[int]models.User{1: {ID: 1, Role: models.RoleManager, OTPSecret: secret, OTPVerified: true}}}
+	authMW := auth.NewMiddleware("secret")
+	h := NewHandler(store, authMW, false)
+
+	pending, err := authMW.GenerateOTPPendingToken(auth.User{ID: 1, Role: auth.RoleManager}, otpTokenTTL)
+	if err != nil {
+		t.Fatalf("GenerateOTPPendingToken: %v", err)
+	}
+	code, err := totp.GenerateCode(secret, time.Now())
+	if err != nil {
+		t.Fatalf("GenerateCode: %v", err)
+	}
+
+	req := httptest.NewRequest(http.MethodPost, "/api/auth/otp/challenge", strings.NewReader(`{"code":"`+code+`"}`))
+	req.Header.Set("Authorization", "Bearer "+pending)
+	rec := httptest.NewRecorder()
+
+	h.Challenge(rec, req)
+
+	if rec.Code != http.StatusOK {
+		t.Fatalf("expected 200, got %d: %s", rec.Code, rec.Body.String())
+	}
+	if !strings.Contains(rec.Body.String(), `"token"`) {
+		t.Fatalf("expected token in response, got %s", rec.Body.String())
+	}
+}
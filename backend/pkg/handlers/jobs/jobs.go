@@ -0,0 +1,118 @@
+// Package jobs implements the HTTP handlers for enqueuing background jobs
+// and streaming their status and log output over WebSocket.
+package jobs
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/gorilla/websocket"
+
+	"tech-management-backend/pkg/jobs"
+	"tech-management-backend/pkg/models"
+)
+
+// Store is the persistence boundary the job handlers depend on for reads.
+type Store interface {
+	Get(id int) (models.Job, error)
+}
+
+// Enqueuer schedules a new job to run on the worker pool.
+type Enqueuer interface {
+	Enqueue(jobType models.JobType, params json.RawMessage) (models.Job, error)
+}
+
+type Handler struct {
+	Store Store
+	Pool  Enqueuer
+	Hub   *jobs.Hub
+
+	upgrader websocket.Upgrader
+}
+
+func NewHandler(store Store, pool Enqueuer, hub *jobs.Hub) *Handler {
+	return &Handler{
+		Store: store,
+		Pool:  pool,
+		Hub:   hub,
+		upgrader: websocket.Upgrader{
+			CheckOrigin: func(r *http.Request) bool { return true },
+		},
+	}
+}
+
+func (h *Handler) Create(w http.ResponseWriter, r *http.Request) {
+	var req struct {
+		Type   models.JobType  `json:"type"`
+		Params json.RawMessage `json:"params"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, "Invalid JSON body", http.StatusBadRequest)
+		return
+	}
+
+	job, err := h.Pool.Enqueue(req.Type, req.Params)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.WriteHeader(http.StatusAccepted)
+	json.NewEncoder(w).Encode(job)
+}
+
+func (h *Handler) Get(w http.ResponseWriter, r *http.Request) {
+	id, err := jobID(r)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	job, err := h.Store.Get(id)
+	if err != nil {
+		http.Error(w, "Job not found", http.StatusNotFound)
+		return
+	}
+	json.NewEncoder(w).Encode(job)
+}
+
+// Stream upgrades the request to a WebSocket and pushes every status and
+// log Event for the job until it finishes or the client disconnects.
+func (h *Handler) Stream(w http.ResponseWriter, r *http.Request) {
+	id, err := jobID(r)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	conn, err := h.upgrader.Upgrade(w, r, nil)
+	if err != nil {
+		return
+	}
+	defer conn.Close()
+
+	events, unsubscribe := h.Hub.Subscribe(id)
+	defer unsubscribe()
+
+	for event := range events {
+		if err := conn.WriteJSON(event); err != nil {
+			return
+		}
+		if event.Done {
+			return
+		}
+	}
+}
+
+func jobID(r *http.Request) (int, error) {
+	path := strings.TrimPrefix(r.URL.Path, "/api/jobs/")
+	path = strings.TrimSuffix(path, "/stream")
+	id, err := strconv.Atoi(path)
+	if err != nil {
+		return 0, fmt.Errorf("invalid job ID")
+	}
+	return id, nil
+}
@@ -0,0 +1,82 @@
+package jobs
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"tech-management-backend/pkg/jobs"
+	"tech-management-backend/pkg/models"
+)
+
+type fakeStore struct {
+	jobs map[int]models.Job
+}
+
+func (f *fakeStore) Get(id int) (models.Job, error) {
+	job, ok := f.jobs[id]
+	if !ok {
+		return models.Job{}, http.ErrNoLocation
+	}
+	return job, nil
+}
+
+type fakePool struct {
+	job models.Job
+}
+
+func (f *fakePool) Enqueue(jobType models.JobType, params json.RawMessage) (models.Job, error) {
+	f.job.Type = jobType
+	f.job.Params = params
+	return f.job, nil
+}
+
+func TestHandler_Create(t *testing.T) {
+	pool := &fakePool{job: models.Job{ID: 1, Status: models.JobPending}}
+	h := NewHandler(&fakeStore{jobs: map[int]models.Job{}}, pool, jobs.NewHub())
+
+	body := strings.NewReader(`{"type":"BULK_ASSIGN"}`)
+	req := httptest.NewRequest(http.MethodPost, "/api/jobs", body)
+	rec := httptest.NewRecorder()
+
+	h.Create(rec, req)
+
+	if rec.Code != http.StatusAccepted {
+		t.Fatalf("expected 202, got %d: %s", rec.Code, rec.Body.String())
+	}
+	if !strings.Contains(rec.Body.String(), `"type":"BULK_ASSIGN"`) {
+		t.Fatalf("expected job type in response body, got %s", rec.Body.String())
+	}
+}
+
+func TestHandler_Get(t *testing.T) {
+	store := &fakeStore{jobs: map[int]models.Job{1: {ID: 1, Status: models.JobSucceeded}}}
+	h := NewHandler(store, &fakePool{}, jobs.NewHub())
+
+	req := httptest.NewRequest(http.MethodGet, "/api/jobs/1", nil)
+	rec := httptest.NewRecorder()
+
+	h.Get(rec, req)
+
+	if rec.Code != http.StatusOK {
+		t.Fatalf("expected 200, got %d", rec.Code)
+	}
+	if !strings.Contains(rec.Body.String(), `"status":"SUCCEEDED"`) {
+		t.Fatalf("expected status in response body, got %s", rec.Body.String())
+	}
+}
+
+func TestHandler_Get_NotFound(t *testing.T) {
+	h := NewHandler(&fakeStore{jobs: map[int]models.Job{}}, &fakePool{}, jobs.NewHub())
+
+	req := httptest.NewRequest(http.MethodGet, "/api/jobs/99", nil)
+	rec := httptest.NewRecorder()
+
+	h.Get(rec, req)
+
+	if rec.Code != http.StatusNotFound {
+		t.Fatalf("expected 404, got %d", rec.Code)
+	}
+}
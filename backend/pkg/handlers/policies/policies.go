@@ -0,0 +1,115 @@
+// Package policies implements the manager-only CRUD HTTP handlers for
+// assignment policies.
+package policies
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"tech-management-backend/pkg/models"
+)
+
+// Store is the persistence boundary the policy handlers depend on.
+type Store interface {
+	List() ([]models.AssignmentPolicy, error)
+	Get(id int) (models.AssignmentPolicy, error)
+	Create(p models.AssignmentPolicy) (models.AssignmentPolicy, error)
+	Update(id int, p models.AssignmentPolicy) error
+	Delete(id int) error
+}
+
+type Handler struct {
+	Store Store
+}
+
+func NewHandler(store Store) *Handler {
+	return &Handler{Store: store}
+}
+
+func (h *Handler) List(w http.ResponseWriter, r *http.Request) {
+	policies, err := h.Store.List()
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	json.NewEncoder(w).Encode(policies)
+}
+
+func (h *Handler) Create(w http.ResponseWriter, r *http.Request) {
+	var p models.AssignmentPolicy
+	if err := json.NewDecoder(r.Body).Decode(&p); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	created, err := h.Store.Create(p)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.WriteHeader(http.StatusCreated)
+	json.NewEncoder(w).Encode(created)
+}
+
+func (h *Handler) Get(w http.ResponseWriter, r *http.Request) {
+	id, err := policyID(r)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	p, err := h.Store.Get(id)
+	if err != nil {
+		http.Error(w, "Policy not found", http.StatusNotFound)
+		return
+	}
+	json.NewEncoder(w).Encode(p)
+}
+
+func (h *Handler) Update(w http.ResponseWriter, r *http.Request) {
+	id, err := policyID(r)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	var p models.AssignmentPolicy
+	if err := json.NewDecoder(r.Body).Decode(&p); err != nil {
+		http.Error(w, "Invalid JSON body", http.StatusBadRequest)
+		return
+	}
+
+	if err := h.Store.Update(id, p); err != nil {
+		http.Error(w, "Update failed: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+	fmt.Fprintf(w, "Policy %d updated", id)
+}
+
+func (h *Handler) Delete(w http.ResponseWriter, r *http.Request) {
+	id, err := policyID(r)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	if err := h.Store.Delete(id); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func policyID(r *http.Request) (int, error) {
+	idStr := strings.TrimPrefix(r.URL.Path, "/api/policies/")
+	id, err := strconv.Atoi(idStr)
+	if err != nil {
+		return 0, fmt.Errorf("invalid policy ID")
+	}
+	return id, nil
+}
@@ -0,0 +1,90 @@
+package policies
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"tech-management-backend/pkg/models"
+)
+
+type fakeStore struct {
+	policies map[int]models.AssignmentPolicy
+}
+
+func (f *fakeStore) List() ([]models.AssignmentPolicy, error) {
+	var out []models.AssignmentPolicy
+	for _, p := range f.policies {
+		out = append(out, p)
+	}
+	return out, nil
+}
+
+func (f *fakeStore) Get(id int) (models.AssignmentPolicy, error) {
+	p, ok := f.policies[id]
+	if !ok {
+		return models.AssignmentPolicy{}, http.ErrNoCookie
+	}
+	return p, nil
+}
+
+func (f *fakeStore) Create(p models.AssignmentPolicy) (models.AssignmentPolicy, error) {
+	p.ID = len(f.policies) + 1
+	f.policies[p.ID] = p
+	return p, nil
+}
+
+func (f *fakeStore) Update(id int, p models.AssignmentPolicy) error {
+	f.policies[id] = p
+	return nil
+}
+
+func (f *fakeStore) Delete(id int) error {
+	delete(f.policies, id)
+	return nil
+}
+
+func TestHandler_CreateAndGet(t *testing.T) {
+	store := &fakeStore{policies: map[int]models.AssignmentPolicy{}}
+	h := NewHandler(store)
+
+	body := strings.NewReader(`{"name":"round robin all","strategy":"ROUND_ROBIN","technicianPool":[1,2]}`)
+	req := httptest.NewRequest(http.MethodPost, "/api/policies", body)
+	rec := httptest.NewRecorder()
+
+	h.Create(rec, req)
+
+	if rec.Code != http.StatusCreated {
+		t.Fatalf("expected 201, got %d: %s", rec.Code, rec.Body.String())
+	}
+
+	getReq := httptest.NewRequest(http.MethodGet, "/api/policies/1", nil)
+	getRec := httptest.NewRecorder()
+
+	h.Get(getRec, getReq)
+
+	if getRec.Code != http.StatusOK {
+		t.Fatalf("expected 200, got %d", getRec.Code)
+	}
+	if !strings.Contains(getRec.Body.String(), "round robin all") {
+		t.Fatalf("expected created policy in response, got %s", getRec.Body.String())
+	}
+}
+
+func TestHandler_Delete(t *testing.T) {
+	store := &fakeStore{policies: map[int]models.AssignmentPolicy{3: {ID: 3}}}
+	h := NewHandler(store)
+
+	req := httptest.NewRequest(http.MethodDelete, "/api/policies/3", nil)
+	rec := httptest.NewRecorder()
+
+	h.Delete(rec, req)
+
+	if rec.Code != http.StatusNoContent {
+		t.Fatalf("expected 204, got %d", rec.Code)
+	}
+	if _, ok := store.policies[3]; ok {
+		t.Fatalf("expected policy 3 to be deleted")
+	}
+}
@@ -0,0 +1,147 @@
+// Package tasks implements the HTTP handlers for task CRUD operations.
+package tasks
+
+import (
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"tech-management-backend/pkg/auth"
+	"tech-management-backend/pkg/log"
+	"tech-management-backend/pkg/models"
+)
+
+// Store is the persistence boundary the task handlers depend on.
+type Store interface {
+	List() ([]models.Task, error)
+	Create(t models.Task) (models.Task, error)
+	Update(id int, t models.Task) error
+	Delete(id int) error
+	TechnicianIDFor(id int) (*int, error)
+}
+
+// Assigner picks a technician for a task with no technician_id, per the
+// assignment policy engine. A nil result means no policy matched.
+type Assigner interface {
+	AssignTechnician(t models.Task) (*int, error)
+}
+
+type Handler struct {
+	Store    Store
+	Assigner Assigner
+}
+
+// NewHandler builds a task Handler. assigner may be nil, in which case
+// tasks created with no technician_id are left unassigned for a manager
+// to pick.
+func NewHandler(store Store, assigner Assigner) *Handler {
+	return &Handler{Store: store, Assigner: assigner}
+}
+
+func (h *Handler) List(w http.ResponseWriter, r *http.Request) {
+	tasks, err := h.Store.List()
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	json.NewEncoder(w).Encode(tasks)
+}
+
+func (h *Handler) Create(w http.ResponseWriter, r *http.Request) {
+	var t models.Task
+	if err := json.NewDecoder(r.Body).Decode(&t); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	if t.TechnicianID == nil && h.Assigner != nil {
+		technicianID, err := h.Assigner.AssignTechnician(t)
+		if err != nil {
+			http.Error(w, "Assignment policy evaluation failed: "+err.Error(), http.StatusInternalServerError)
+			return
+		}
+		t.TechnicianID = technicianID
+	}
+
+	created, err := h.Store.Create(t)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.WriteHeader(http.StatusCreated)
+	json.NewEncoder(w).Encode(created)
+}
+
+// Update overwrites a task's fields. A technician may only update a task
+// assigned to them; managers may update any task.
+func (h *Handler) Update(w http.ResponseWriter, r *http.Request) {
+	id, err := taskID(r)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	user, _ := auth.UserFromContext(r.Context())
+	if user.Role == auth.RoleTechnician {
+		technicianID, err := h.Store.TechnicianIDFor(id)
+		if err != nil {
+			http.Error(w, "Task not found", http.StatusNotFound)
+			return
+		}
+		if technicianID == nil || *technicianID != user.ID {
+			http.Error(w, "Forbidden", http.StatusForbidden)
+			return
+		}
+	}
+
+	var t models.Task
+	if err := json.NewDecoder(r.Body).Decode(&t); err != nil {
+		http.Error(w, "Invalid JSON body", http.StatusBadRequest)
+		return
+	}
+
+	if err := h.Store.Update(id, t); err != nil {
+		requestID := log.RequestIDFromContext(r.Context())
+		if err == sql.ErrNoRows {
+			log.Warn("task update failed: not found", "request_id", requestID, "task_id", id)
+			http.Error(w, "Task not found", http.StatusNotFound)
+			return
+		}
+		log.Error("task update failed", "request_id", requestID, "task_id", id, "error", err)
+		http.Error(w, "Database update failed", http.StatusInternalServerError)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	fmt.Fprintf(w, "Task %d updated successfully", id)
+}
+
+func (h *Handler) Delete(w http.ResponseWriter, r *http.Request) {
+	id, err := taskID(r)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	if err := h.Store.Delete(id); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func taskID(r *http.Request) (int, error) {
+	idStr := strings.TrimPrefix(r.URL.Path, "/api/tasks/")
+	if idStr == "" {
+		return 0, fmt.Errorf("task ID required")
+	}
+	id, err := strconv.Atoi(idStr)
+	if err != nil {
+		return 0, fmt.Errorf("invalid task ID")
+	}
+	return id, nil
+}
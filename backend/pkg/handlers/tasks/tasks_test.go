@@ -0,0 +1,168 @@
+package tasks
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"tech-management-backend/pkg/auth"
+	"tech-management-backend/pkg/models"
+)
+
+type fakeStore struct {
+	tasks         map[int]models.Task
+	technicianIDs map[int]*int
+	updateErr     error
+}
+
+func (f *fakeStore) List() ([]models.Task, error) {
+	var out []models.Task
+	for _, t := range f.tasks {
+		out = append(out, t)
+	}
+	return out, nil
+}
+
+func (f *fakeStore) Create(t models.Task) (models.Task, error) {
+	t.ID = len(f.tasks) + 1
+	f.tasks[t.ID] = t
+	return t, nil
+}
+
+func (f *fakeStore) Update(id int, t models.Task) error {
+	if f.updateErr != nil {
+		return f.updateErr
+	}
+	f.tasks[id] = t
+	return nil
+}
+
+func (f *fakeStore) Delete(id int) error {
+	delete(f.tasks, id)
+	return nil
+}
+
+func (f *fakeStore) TechnicianIDFor(id int) (*int, error) {
+	return f.technicianIDs[id], nil
+}
+
+func TestHandler_Update_TechnicianOwnershipEnforced(t *testing.T) {
+	technicianID := 7
+	otherID := 9
+
+	tests := []struct {
+		name       string
+		user       auth.User
+		store      *fakeStore
+		wantStatus int
+	}{
+		{
+			name: "technician updating own task succeeds",
+			user: auth.User{ID: technicianID, Role: auth.RoleTechnician},
+			store: &fakeStore{
+				tasks:         map[int]models.Task{1: {ID: 1}},
+				technicianIDs: map[int]*int{1: &technicianID},
+			},
+			wantStatus: http.StatusOK,
+		},
+		{
+			name: "technician updating someone else's task is forbidden",
+			user: auth.User{ID: technicianID, Role: auth.RoleTechnician},
+			store: &fakeStore{
+				tasks:         map[int]models.Task{1: {ID: 1}},
+				technicianIDs: map[int]*int{1: &otherID},
+			},
+			wantStatus: http.StatusForbidden,
+		},
+		{
+			name: "manager can update any task",
+			user: auth.User{ID: 1, Role: auth.RoleManager},
+			store: &fakeStore{
+				tasks:         map[int]models.Task{1: {ID: 1}},
+				technicianIDs: map[int]*int{1: &otherID},
+			},
+			wantStatus: http.StatusOK,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			h := NewHandler(tc.store, nil)
+			body := strings.NewReader(`{"title":"updated"}`)
+			req := httptest.NewRequest(http.MethodPut, "/api/tasks/1", body)
+			req = req.WithContext(auth.WithUser(req.Context(), tc.user))
+			rec := httptest.NewRecorder()
+
+			h.Update(rec, req)
+
+			if rec.Code != tc.wantStatus {
+				t.Fatalf("expected status %d, got %d: %s", tc.wantStatus, rec.Code, rec.Body.String())
+			}
+		})
+	}
+}
+
+func TestHandler_List(t *testing.T) {
+	store := &fakeStore{tasks: map[int]models.Task{1: {ID: 1, Title: "a"}}}
+	h := NewHandler(store, nil)
+
+	req := httptest.NewRequest(http.MethodGet, "/api/tasks", nil)
+	rec := httptest.NewRecorder()
+
+	h.List(rec, req)
+
+	if rec.Code != http.StatusOK {
+		t.Fatalf("expected 200, got %d", rec.Code)
+	}
+	if !strings.Contains(rec.Body.String(), `"title":"a"`) {
+		t.Fatalf("expected task in response body, got %s", rec.Body.String())
+	}
+}
+
+type fakeAssigner struct {
+	technicianID *int
+}
+
+func (f *fakeAssigner) AssignTechnician(t models.Task) (*int, error) {
+	return f.technicianID, nil
+}
+
+func TestHandler_Create_AutoAssignsWhenUnassigned(t *testing.T) {
+	picked := 42
+	store := &fakeStore{tasks: map[int]models.Task{}}
+	h := NewHandler(store, &fakeAssigner{technicianID: &picked})
+
+	body := strings.NewReader(`{"title":"new task","client":"Acme"}`)
+	req := httptest.NewRequest(http.MethodPost, "/api/tasks", body)
+	rec := httptest.NewRecorder()
+
+	h.Create(rec, req)
+
+	if rec.Code != http.StatusCreated {
+		t.Fatalf("expected 201, got %d: %s", rec.Code, rec.Body.String())
+	}
+	if store.tasks[1].TechnicianID == nil || *store.tasks[1].TechnicianID != picked {
+		t.Fatalf("expected task auto-assigned to technician %d, got %+v", picked, store.tasks[1])
+	}
+}
+
+func TestHandler_Create_RespectsExplicitTechnician(t *testing.T) {
+	explicit := 7
+	picked := 42
+	store := &fakeStore{tasks: map[int]models.Task{}}
+	h := NewHandler(store, &fakeAssigner{technicianID: &picked})
+
+	body := strings.NewReader(`{"title":"new task","technicianId":7}`)
+	req := httptest.NewRequest(http.MethodPost, "/api/tasks", body)
+	rec := httptest.NewRecorder()
+
+	h.Create(rec, req)
+
+	if rec.Code != http.StatusCreated {
+		t.Fatalf("expected 201, got %d: %s", rec.Code, rec.Body.String())
+	}
+	if store.tasks[1].TechnicianID == nil || *store.tasks[1].TechnicianID != explicit {
+		t.Fatalf("expected explicit technician %d to be respected, got %+v", explicit, store.tasks[1])
+	}
+}
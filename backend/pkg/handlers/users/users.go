@@ -0,0 +1,118 @@
+// Package users implements the HTTP handlers for user administration.
+package users
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"tech-management-backend/pkg/models"
+)
+
+// Store is the persistence boundary the user handlers depend on.
+type Store interface {
+	Update(id int, u models.User) error
+	UpdateRole(id int, role models.UserRole) error
+	Delete(id int) error
+	ListByRole(role models.UserRole) ([]models.User, error)
+}
+
+type Handler struct {
+	Store Store
+}
+
+func NewHandler(store Store) *Handler {
+	return &Handler{Store: store}
+}
+
+func (h *Handler) Update(w http.ResponseWriter, r *http.Request) {
+	id, err := userID(r.URL.Path, "/api/users/")
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	var u models.User
+	if err := json.NewDecoder(r.Body).Decode(&u); err != nil {
+		http.Error(w, "Invalid JSON", http.StatusBadRequest)
+		return
+	}
+
+	if err := h.Store.Update(id, u); err != nil {
+		http.Error(w, "Update failed: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	fmt.Fprintf(w, "User %d updated", id)
+}
+
+func (h *Handler) UpdateRole(w http.ResponseWriter, r *http.Request) {
+	// path is /api/users/{id}/role, so the ID is the third segment
+	parts := strings.Split(r.URL.Path, "/")
+	if len(parts) < 4 {
+		http.Error(w, "Invalid path", http.StatusBadRequest)
+		return
+	}
+	id, err := strconv.Atoi(parts[3])
+	if err != nil {
+		http.Error(w, "Invalid user ID", http.StatusBadRequest)
+		return
+	}
+
+	var body struct {
+		Role models.UserRole `json:"role"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		http.Error(w, "Invalid JSON", http.StatusBadRequest)
+		return
+	}
+
+	if err := h.Store.UpdateRole(id, body.Role); err != nil {
+		http.Error(w, "Update failed", http.StatusInternalServerError)
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+}
+
+func (h *Handler) Delete(w http.ResponseWriter, r *http.Request) {
+	id, err := userID(r.URL.Path, "/api/users/")
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	if err := h.Store.Delete(id); err != nil {
+		http.Error(w, "Delete failed: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (h *Handler) ListTechnicians(w http.ResponseWriter, r *http.Request) {
+	h.listByRole(w, models.RoleTechnician)
+}
+
+func (h *Handler) ListManagers(w http.ResponseWriter, r *http.Request) {
+	h.listByRole(w, models.RoleManager)
+}
+
+func (h *Handler) listByRole(w http.ResponseWriter, role models.UserRole) {
+	users, err := h.Store.ListByRole(role)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	json.NewEncoder(w).Encode(users)
+}
+
+func userID(path, prefix string) (int, error) {
+	idStr := strings.TrimPrefix(path, prefix)
+	id, err := strconv.Atoi(idStr)
+	if err != nil {
+		return 0, fmt.Errorf("invalid user ID")
+	}
+	return id, nil
+}
@@ -0,0 +1,103 @@
+package users
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"tech-management-backend/pkg/models"
+)
+
+type fakeStore struct {
+	users     map[int]models.User
+	deleted   []int
+	roleSeen  models.UserRole
+	updateErr error
+}
+
+func (f *fakeStore) Update(id int, u models.User) error {
+	if f.updateErr != nil {
+		return f.updateErr
+	}
+	f.users[id] = u
+	return nil
+}
+
+func (f *fakeStore) UpdateRole(id int, role models.UserRole) error {
+	u := f.users[id]
+	u.Role = role
+	f.users[id] = u
+	return nil
+}
+
+func (f *fakeStore) Delete(id int) error {
+	f.deleted = append(f.deleted, id)
+	return nil
+}
+
+func (f *fakeStore) ListByRole(role models.UserRole) ([]models.User, error) {
+	f.roleSeen = role
+	var out []models.User
+	for _, u := range f.users {
+		if u.Role == role {
+			out = append(out, u)
+		}
+	}
+	return out, nil
+}
+
+func TestHandler_Delete(t *testing.T) {
+	store := &fakeStore{users: map[int]models.User{5: {ID: 5}}}
+	h := NewHandler(store)
+
+	req := httptest.NewRequest(http.MethodDelete, "/api/users/5", nil)
+	rec := httptest.NewRecorder()
+
+	h.Delete(rec, req)
+
+	if rec.Code != http.StatusNoContent {
+		t.Fatalf("expected 204, got %d", rec.Code)
+	}
+	if len(store.deleted) != 1 || store.deleted[0] != 5 {
+		t.Fatalf("expected user 5 to be deleted, got %v", store.deleted)
+	}
+}
+
+func TestHandler_UpdateRole(t *testing.T) {
+	store := &fakeStore{users: map[int]models.User{5: {ID: 5, Role: models.RoleTechnician}}}
+	h := NewHandler(store)
+
+	body := strings.NewReader(`{"role":"MANAGER"}`)
+	req := httptest.NewRequest(http.MethodPatch, "/api/users/5/role", body)
+	rec := httptest.NewRecorder()
+
+	h.UpdateRole(rec, req)
+
+	if rec.Code != http.StatusOK {
+		t.Fatalf("expected 200, got %d: %s", rec.Code, rec.Body.String())
+	}
+	if store.users[5].Role != models.RoleManager {
+		t.Fatalf("expected role to be updated to MANAGER, got %s", store.users[5].Role)
+	}
+}
+
+func TestHandler_ListTechnicians(t *testing.T) {
+	store := &fakeStore{users: map[int]models.User{
+		1: {ID: 1, Role: models.RoleTechnician},
+		2: {ID: 2, Role: models.RoleManager},
+	}}
+	h := NewHandler(store)
+
+	req := httptest.NewRequest(http.MethodGet, "/api/technicians", nil)
+	rec := httptest.NewRecorder()
+
+	h.ListTechnicians(rec, req)
+
+	if rec.Code != http.StatusOK {
+		t.Fatalf("expected 200, got %d", rec.Code)
+	}
+	if store.roleSeen != models.RoleTechnician {
+		t.Fatalf("expected ListByRole called with TECHNICIAN, got %s", store.roleSeen)
+	}
+}
@@ -0,0 +1,62 @@
+package jobs
+
+import (
+	"sync"
+
+	"tech-management-backend/pkg/models"
+)
+
+// Event is a status or log update pushed to job stream subscribers.
+type Event struct {
+	Status models.JobStatus `json:"status,omitempty"`
+	Log    string           `json:"log,omitempty"`
+	Done   bool             `json:"done,omitempty"`
+}
+
+// Hub fans out job Events to every stream subscribed to a given job ID.
+type Hub struct {
+	mu          sync.Mutex
+	subscribers map[int][]chan Event
+}
+
+func NewHub() *Hub {
+	return &Hub{subscribers: map[int][]chan Event{}}
+}
+
+// Subscribe registers a buffered channel for jobID's events and returns it
+// along with a function to unsubscribe and close the channel.
+func (h *Hub) Subscribe(jobID int) (<-chan Event, func()) {
+	ch := make(chan Event, 16)
+
+	h.mu.Lock()
+	h.subscribers[jobID] = append(h.subscribers[jobID], ch)
+	h.mu.Unlock()
+
+	unsubscribe := func() {
+		h.mu.Lock()
+		defer h.mu.Unlock()
+		subs := h.subscribers[jobID]
+		for i, c := range subs {
+			if c == ch {
+				h.subscribers[jobID] = append(subs[:i], subs[i+1:]...)
+				break
+			}
+		}
+		close(ch)
+	}
+	return ch, unsubscribe
+}
+
+// Publish delivers event to every current subscriber of jobID. A subscriber
+// whose buffer is full misses the event rather than blocking the worker; it
+// stays subscribed.
+func (h *Hub) Publish(jobID int, event Event) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	for _, ch := range h.subscribers[jobID] {
+		select {
+		case ch <- event:
+		default:
+		}
+	}
+}
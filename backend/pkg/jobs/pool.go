@@ -0,0 +1,119 @@
+// Package jobs runs long-running task operations (bulk assignment, CSV
+// export/import, technician notifications) on a worker pool and streams
+// their status and log output to subscribers via Hub.
+package jobs
+
+import (
+	"context"
+	"encoding/json"
+	"sync"
+
+	"tech-management-backend/pkg/models"
+)
+
+// Runner executes job with the given params, calling report to append a
+// line to the job's log as it progresses. An error return marks the job
+// FAILED; a nil return marks it SUCCEEDED.
+type Runner func(ctx context.Context, job models.Job, report func(string)) error
+
+// Store is the persistence boundary the pool needs for jobs.
+type Store interface {
+	Create(job models.Job) (models.Job, error)
+	Get(id int) (models.Job, error)
+	MarkRunning(id int) error
+	AppendLog(id int, line string) error
+	MarkFinished(id int, status models.JobStatus) error
+}
+
+// Pool runs enqueued jobs on a fixed number of worker goroutines, recording
+// status transitions in Store and publishing them to Hub.
+type Pool struct {
+	store   Store
+	hub     *Hub
+	runners map[models.JobType]Runner
+
+	queue  chan models.Job
+	ctx    context.Context
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
+// NewPool starts workers worker goroutines pulling from an internal queue.
+func NewPool(store Store, hub *Hub, workers int, runners map[models.JobType]Runner) *Pool {
+	ctx, cancel := context.WithCancel(context.Background())
+	p := &Pool{
+		store:   store,
+		hub:     hub,
+		runners: runners,
+		queue:   make(chan models.Job, 64),
+		ctx:     ctx,
+		cancel:  cancel,
+	}
+
+	for i := 0; i < workers; i++ {
+		p.wg.Add(1)
+		go p.worker()
+	}
+	return p
+}
+
+// Enqueue persists a new job in PENDING status and schedules it to run.
+func (p *Pool) Enqueue(jobType models.JobType, params json.RawMessage) (models.Job, error) {
+	job, err := p.store.Create(models.Job{Type: jobType, Status: models.JobPending, Params: params})
+	if err != nil {
+		return models.Job{}, err
+	}
+
+	p.queue <- job
+	return job, nil
+}
+
+func (p *Pool) worker() {
+	defer p.wg.Done()
+	for {
+		select {
+		case <-p.ctx.Done():
+			return
+		case job, ok := <-p.queue:
+			if !ok {
+				return
+			}
+			p.run(job)
+		}
+	}
+}
+
+func (p *Pool) run(job models.Job) {
+	runner, ok := p.runners[job.Type]
+	if !ok {
+		p.store.MarkFinished(job.ID, models.JobFailed)
+		p.hub.Publish(job.ID, Event{Status: models.JobFailed, Log: "no runner registered for job type " + string(job.Type), Done: true})
+		return
+	}
+
+	if err := p.store.MarkRunning(job.ID); err == nil {
+		p.hub.Publish(job.ID, Event{Status: models.JobRunning})
+	}
+
+	report := func(line string) {
+		p.store.AppendLog(job.ID, line)
+		p.hub.Publish(job.ID, Event{Log: line})
+	}
+
+	status := models.JobSucceeded
+	if err := runner(p.ctx, job, report); err != nil {
+		status = models.JobFailed
+		report(err.Error())
+	}
+
+	p.store.MarkFinished(job.ID, status)
+	p.hub.Publish(job.ID, Event{Status: status, Done: true})
+}
+
+// Shutdown stops accepting new work, cancels the context passed to every
+// Runner so in-flight jobs can stop early, and waits for workers to exit.
+func (p *Pool) Shutdown() {
+	close(p.queue)
+	p.cancel()
+	p.wg.Wait()
+}
@@ -0,0 +1,168 @@
+package jobs
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"tech-management-backend/pkg/models"
+)
+
+type fakeStore struct {
+	mu   sync.Mutex
+	jobs map[int]models.Job
+	next int
+}
+
+func newFakeStore() *fakeStore {
+	return &fakeStore{jobs: map[int]models.Job{}}
+}
+
+func (f *fakeStore) Create(job models.Job) (models.Job, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.next++
+	job.ID = f.next
+	f.jobs[job.ID] = job
+	return job, nil
+}
+
+func (f *fakeStore) Get(id int) (models.Job, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.jobs[id], nil
+}
+
+func (f *fakeStore) MarkRunning(id int) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	job := f.jobs[id]
+	job.Status = models.JobRunning
+	f.jobs[id] = job
+	return nil
+}
+
+func (f *fakeStore) AppendLog(id int, line string) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	job := f.jobs[id]
+	job.Log += line + "\n"
+	f.jobs[id] = job
+	return nil
+}
+
+func (f *fakeStore) MarkFinished(id int, status models.JobStatus) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	job := f.jobs[id]
+	job.Status = status
+	f.jobs[id] = job
+	return nil
+}
+
+func (f *fakeStore) status(id int) models.JobStatus {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.jobs[id].Status
+}
+
+func waitForStatus(t *testing.T, store *fakeStore, id int, want models.JobStatus) {
+	t.Helper()
+	deadline := time.Now().Add(2 * time.Second)
+	for time.Now().Before(deadline) {
+		if store.status(id) == want {
+			return
+		}
+		time.Sleep(5 * time.Millisecond)
+	}
+	t.Fatalf("job %d never reached status %s, got %s", id, want, store.status(id))
+}
+
+func TestPool_RunsSucceedingJob(t *testing.T) {
+	store := newFakeStore()
+	hub := NewHub()
+	runners := map[models.JobType]Runner{
+		models.JobNotifyTechnician: func(ctx context.Context, job models.Job, report func(string)) error {
+			report("notified")
+			return nil
+		},
+	}
+	pool := NewPool(store, hub, 1, runners)
+	defer pool.Shutdown()
+
+	job, err := pool.Enqueue(models.JobNotifyTechnician, nil)
+	if err != nil {
+		t.Fatalf("Enqueue returned error: %v", err)
+	}
+
+	waitForStatus(t, store, job.ID, models.JobSucceeded)
+}
+
+func TestPool_RunsFailingJob(t *testing.T) {
+	store := newFakeStore()
+	hub := NewHub()
+	runners := map[models.JobType]Runner{
+		models.JobBulkAssign: func(ctx context.Context, job models.Job, report func(string)) error {
+			return errors.New("boom")
+		},
+	}
+	pool := NewPool(store, hub, 1, runners)
+	defer pool.Shutdown()
+
+	job, err := pool.Enqueue(models.JobBulkAssign, nil)
+	if err != nil {
+		t.Fatalf("Enqueue returned error: %v", err)
+	}
+
+	waitForStatus(t, store, job.ID, models.JobFailed)
+}
+
+func TestPool_ShutdownCancelsContextForInFlightJob(t *testing.T) {
+	store := newFakeStore()
+	hub := NewHub()
+	started := make(chan struct{})
+	stopped := make(chan struct{})
+	runners := map[models.JobType]Runner{
+		models.JobNotifyTechnician: func(ctx context.Context, job models.Job, report func(string)) error {
+			close(started)
+			<-ctx.Done()
+			close(stopped)
+			return ctx.Err()
+		},
+	}
+	pool := NewPool(store, hub, 1, runners)
+
+	if _, err := pool.Enqueue(models.JobNotifyTechnician, nil); err != nil {
+		t.Fatalf("Enqueue returned error: %v", err)
+	}
+
+	<-started
+	done := make(chan struct{})
+	go func() {
+		pool.Shutdown()
+		close(done)
+	}()
+
+	select {
+	case <-stopped:
+	case <-time.After(2 * time.Second):
+		t.Fatal("Shutdown did not cancel the in-flight job's context")
+	}
+	<-done
+}
+
+func TestPool_UnknownJobTypeFails(t *testing.T) {
+	store := newFakeStore()
+	hub := NewHub()
+	pool := NewPool(store, hub, 1, map[models.JobType]Runner{})
+	defer pool.Shutdown()
+
+	job, err := pool.Enqueue(models.JobImportTasks, nil)
+	if err != nil {
+		t.Fatalf("Enqueue returned error: %v", err)
+	}
+
+	waitForStatus(t, store, job.ID, models.JobFailed)
+}
@@ -0,0 +1,175 @@
+package jobs
+
+import (
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"tech-management-backend/pkg/models"
+)
+
+// TaskStore is the persistence boundary the built-in runners need for tasks.
+type TaskStore interface {
+	List() ([]models.Task, error)
+	Create(t models.Task) (models.Task, error)
+	Update(id int, t models.Task) error
+}
+
+// Assigner picks a technician for a task per the assignment policy engine.
+type Assigner interface {
+	AssignTechnician(t models.Task) (*int, error)
+}
+
+// DefaultRunners builds the Runner for every models.JobType the API exposes.
+func DefaultRunners(tasks TaskStore, assigner Assigner) map[models.JobType]Runner {
+	return map[models.JobType]Runner{
+		models.JobBulkAssign:       bulkAssignRunner(tasks, assigner),
+		models.JobExportTasksCSV:   exportTasksCSVRunner(tasks),
+		models.JobImportTasks:      importTasksRunner(tasks),
+		models.JobNotifyTechnician: notifyTechnicianRunner(tasks),
+	}
+}
+
+// bulkAssignRunner assigns a technician, via the policy engine, to every
+// task that currently has none.
+func bulkAssignRunner(tasks TaskStore, assigner Assigner) Runner {
+	return func(ctx context.Context, job models.Job, report func(string)) error {
+		all, err := tasks.List()
+		if err != nil {
+			return err
+		}
+
+		assigned := 0
+		for _, t := range all {
+			select {
+			case <-ctx.Done():
+				return ctx.Err()
+			default:
+			}
+			if t.TechnicianID != nil {
+				continue
+			}
+			technicianID, err := assigner.AssignTechnician(t)
+			if err != nil {
+				return fmt.Errorf("task %d: %w", t.ID, err)
+			}
+			if technicianID == nil {
+				continue
+			}
+			t.TechnicianID = technicianID
+			if err := tasks.Update(t.ID, t); err != nil {
+				return fmt.Errorf("task %d: %w", t.ID, err)
+			}
+			assigned++
+			report(fmt.Sprintf("assigned task %d to technician %d", t.ID, *technicianID))
+		}
+		report(fmt.Sprintf("bulk assign complete: %d task(s) assigned", assigned))
+		return nil
+	}
+}
+
+// exportTasksCSVRunner writes every task as CSV, one row logged per line, so
+// a subscriber streaming the job's log can reconstruct the full export.
+func exportTasksCSVRunner(tasks TaskStore) Runner {
+	return func(ctx context.Context, job models.Job, report func(string)) error {
+		all, err := tasks.List()
+		if err != nil {
+			return err
+		}
+
+		report("id,title,status,priority,technician_id,client")
+		for _, t := range all {
+			select {
+			case <-ctx.Done():
+				return ctx.Err()
+			default:
+			}
+			var buf strings.Builder
+			w := csv.NewWriter(&buf)
+			technicianID := ""
+			if t.TechnicianID != nil {
+				technicianID = fmt.Sprint(*t.TechnicianID)
+			}
+			w.Write([]string{fmt.Sprint(t.ID), t.Title, t.Status, t.Priority, technicianID, t.Client})
+			w.Flush()
+			report(strings.TrimRight(buf.String(), "\n"))
+		}
+		report(fmt.Sprintf("export complete: %d task(s)", len(all)))
+		return nil
+	}
+}
+
+// importTasksRunner decodes job.Params as a CSV import request and creates
+// one task per row.
+func importTasksRunner(tasks TaskStore) Runner {
+	return func(ctx context.Context, job models.Job, report func(string)) error {
+		var params struct {
+			CSV string `json:"csv"`
+		}
+		if err := json.Unmarshal(job.Params, &params); err != nil {
+			return fmt.Errorf("invalid import params: %w", err)
+		}
+
+		rows, err := csv.NewReader(strings.NewReader(params.CSV)).ReadAll()
+		if err != nil {
+			return fmt.Errorf("invalid CSV: %w", err)
+		}
+
+		created := 0
+		for _, row := range rows {
+			select {
+			case <-ctx.Done():
+				return ctx.Err()
+			default:
+			}
+			if len(row) < 3 {
+				report(fmt.Sprintf("skipping malformed row: %v", row))
+				continue
+			}
+			t := models.Task{Title: row[0], Description: row[1], Client: row[2], Status: "PENDING", Priority: "MEDIUM"}
+			if _, err := tasks.Create(t); err != nil {
+				return fmt.Errorf("row %v: %w", row, err)
+			}
+			created++
+			report(fmt.Sprintf("imported task %q", t.Title))
+		}
+		report(fmt.Sprintf("import complete: %d task(s) created", created))
+		return nil
+	}
+}
+
+// notifyTechnicianRunner decodes job.Params for a technician ID and logs a
+// notification line per task currently assigned to them.
+func notifyTechnicianRunner(tasks TaskStore) Runner {
+	return func(ctx context.Context, job models.Job, report func(string)) error {
+		var params struct {
+			TechnicianID int `json:"technicianId"`
+		}
+		if err := json.Unmarshal(job.Params, &params); err != nil {
+			return fmt.Errorf("invalid notify params: %w", err)
+		}
+
+		all, err := tasks.List()
+		if err != nil {
+			return err
+		}
+
+		notified := 0
+		for _, t := range all {
+			select {
+			case <-ctx.Done():
+				return ctx.Err()
+			default:
+			}
+			if t.TechnicianID == nil || *t.TechnicianID != params.TechnicianID {
+				continue
+			}
+			notified++
+			report(fmt.Sprintf("notified technician %d of task %d: %s", params.TechnicianID, t.ID, t.Title))
+		}
+		report(fmt.Sprintf("notification complete: %d task(s)", notified))
+		return nil
+	}
+}
@@ -0,0 +1,109 @@
+package jobs
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"tech-management-backend/pkg/models"
+)
+
+type fakeTaskStore struct {
+	tasks map[int]models.Task
+	next  int
+}
+
+func (f *fakeTaskStore) List() ([]models.Task, error) {
+	var out []models.Task
+	for _, t := range f.tasks {
+		out = append(out, t)
+	}
+	return out, nil
+}
+
+func (f *fakeTaskStore) Create(t models.Task) (models.Task, error) {
+	f.next++
+	t.ID = f.next
+	f.tasks[t.ID] = t
+	return t, nil
+}
+
+func (f *fakeTaskStore) Update(id int, t models.Task) error {
+	f.tasks[id] = t
+	return nil
+}
+
+type fakeAssigner struct {
+	technicianID *int
+}
+
+func (f *fakeAssigner) AssignTechnician(t models.Task) (*int, error) {
+	return f.technicianID, nil
+}
+
+func TestBulkAssignRunner_AssignsUnassignedTasks(t *testing.T) {
+	picked := 5
+	store := &fakeTaskStore{tasks: map[int]models.Task{1: {ID: 1, Title: "a"}}}
+	runner := bulkAssignRunner(store, &fakeAssigner{technicianID: &picked})
+
+	var logs []string
+	if err := runner(context.Background(), models.Job{}, func(line string) { logs = append(logs, line) }); err != nil {
+		t.Fatalf("runner returned error: %v", err)
+	}
+
+	if store.tasks[1].TechnicianID == nil || *store.tasks[1].TechnicianID != picked {
+		t.Fatalf("expected task assigned to technician %d, got %+v", picked, store.tasks[1])
+	}
+	if len(logs) == 0 {
+		t.Fatal("expected at least one log line")
+	}
+}
+
+func TestImportTasksRunner_CreatesOneTaskPerRow(t *testing.T) {
+	store := &fakeTaskStore{tasks: map[int]models.Task{}}
+	runner := importTasksRunner(store)
+
+	params, _ := json.Marshal(map[string]string{"csv": "Fix leak,Kitchen sink,Acme\nReplace filter,HVAC,Acme\n"})
+	if err := runner(context.Background(), models.Job{Params: params}, func(string) {}); err != nil {
+		t.Fatalf("runner returned error: %v", err)
+	}
+
+	if len(store.tasks) != 2 {
+		t.Fatalf("expected 2 tasks created, got %d", len(store.tasks))
+	}
+}
+
+func TestExportTasksCSVRunner_LogsHeaderAndRows(t *testing.T) {
+	store := &fakeTaskStore{tasks: map[int]models.Task{1: {ID: 1, Title: "a", Client: "Acme"}}}
+	runner := exportTasksCSVRunner(store)
+
+	var logs []string
+	if err := runner(context.Background(), models.Job{}, func(line string) { logs = append(logs, line) }); err != nil {
+		t.Fatalf("runner returned error: %v", err)
+	}
+
+	if !strings.HasPrefix(logs[0], "id,title,status") {
+		t.Fatalf("expected CSV header first, got %q", logs[0])
+	}
+}
+
+func TestExportTasksCSVRunner_StopsWhenContextCanceled(t *testing.T) {
+	store := &fakeTaskStore{tasks: map[int]models.Task{
+		1: {ID: 1, Title: "a", Client: "Acme"},
+		2: {ID: 2, Title: "b", Client: "Acme"},
+	}}
+	runner := exportTasksCSVRunner(store)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	var logs []string
+	err := runner(ctx, models.Job{}, func(line string) { logs = append(logs, line) })
+	if err != context.Canceled {
+		t.Fatalf("expected context.Canceled, got %v", err)
+	}
+	if len(logs) != 1 {
+		t.Fatalf("expected only the header line logged before bailing, got %v", logs)
+	}
+}
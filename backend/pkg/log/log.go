@@ -0,0 +1,146 @@
+// Package log is a small structured, leveled logger. Level defaults to INFO
+// and is configurable via the LOG_LEVEL env var; output is plain text unless
+// LOG_FORMAT=json, in which case each line is a JSON object.
+package log
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"sync"
+	"time"
+)
+
+type Level int
+
+const (
+	LevelDebug Level = iota
+	LevelInfo
+	LevelWarn
+	LevelError
+)
+
+func (l Level) String() string {
+	switch l {
+	case LevelDebug:
+		return "DEBUG"
+	case LevelInfo:
+		return "INFO"
+	case LevelWarn:
+		return "WARN"
+	case LevelError:
+		return "ERROR"
+	default:
+		return "UNKNOWN"
+	}
+}
+
+func parseLevel(s string) (Level, bool) {
+	switch strings.ToUpper(s) {
+	case "DEBUG":
+		return LevelDebug, true
+	case "INFO":
+		return LevelInfo, true
+	case "WARN", "WARNING":
+		return LevelWarn, true
+	case "ERROR":
+		return LevelError, true
+	default:
+		return LevelInfo, false
+	}
+}
+
+var (
+	mu         sync.Mutex
+	out        io.Writer = os.Stdout
+	minLevel             = LevelInfo
+	jsonFormat           = false
+)
+
+// Configure applies LOG_LEVEL and LOG_FORMAT from the environment. Call it
+// once at startup after loading any .env file (godotenv.Load), the same way
+// pkg/config.Load is called, so a level or format set only in .env is
+// actually picked up. Until Configure runs, logging uses the INFO/text
+// defaults.
+func Configure() {
+	mu.Lock()
+	defer mu.Unlock()
+
+	if lv, ok := os.LookupEnv("LOG_LEVEL"); ok {
+		if parsed, ok := parseLevel(lv); ok {
+			minLevel = parsed
+		}
+	}
+	jsonFormat = os.Getenv("LOG_FORMAT") == "json"
+}
+
+func logf(level Level, msg string, kv []interface{}) {
+	mu.Lock()
+	defer mu.Unlock()
+
+	if level < minLevel {
+		return
+	}
+
+	now := time.Now().UTC().Format(time.RFC3339)
+
+	if jsonFormat {
+		entry := map[string]interface{}{"time": now, "level": level.String(), "msg": msg}
+		for i := 0; i+1 < len(kv); i += 2 {
+			key, ok := kv[i].(string)
+			if !ok {
+				continue
+			}
+			entry[key] = kv[i+1]
+		}
+		b, err := json.Marshal(entry)
+		if err != nil {
+			return
+		}
+		fmt.Fprintln(out, string(b))
+		return
+	}
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "time=%s level=%s msg=%q", now, level.String(), msg)
+	for i := 0; i+1 < len(kv); i += 2 {
+		fmt.Fprintf(&b, " %v=%v", kv[i], kv[i+1])
+	}
+	fmt.Fprintln(out, b.String())
+}
+
+// Debug logs msg at DEBUG level with alternating key-value pairs in kv.
+func Debug(msg string, kv ...interface{}) { logf(LevelDebug, msg, kv) }
+
+// Info logs msg at INFO level with alternating key-value pairs in kv.
+func Info(msg string, kv ...interface{}) { logf(LevelInfo, msg, kv) }
+
+// Warn logs msg at WARN level with alternating key-value pairs in kv.
+func Warn(msg string, kv ...interface{}) { logf(LevelWarn, msg, kv) }
+
+// Error logs msg at ERROR level with alternating key-value pairs in kv.
+func Error(msg string, kv ...interface{}) { logf(LevelError, msg, kv) }
+
+// Fatal logs msg at ERROR level with alternating key-value pairs in kv, then
+// exits the process with status 1.
+func Fatal(msg string, kv ...interface{}) {
+	logf(LevelError, msg, kv)
+	os.Exit(1)
+}
+
+// SetOutput redirects log output to w and returns a function that restores
+// the previous output. Exported for tests in other packages that need to
+// assert on log content without touching the package-private out var.
+func SetOutput(w io.Writer) (restore func()) {
+	mu.Lock()
+	prev := out
+	out = w
+	mu.Unlock()
+	return func() {
+		mu.Lock()
+		out = prev
+		mu.Unlock()
+	}
+}
@@ -0,0 +1,101 @@
+package log
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"testing"
+)
+
+func TestLogf_TextFormat(t *testing.T) {
+	var buf bytes.Buffer
+	prevOut, prevJSON, prevLevel := out, jsonFormat, minLevel
+	out = &buf
+	jsonFormat = false
+	minLevel = LevelDebug
+	defer func() { out, jsonFormat, minLevel = prevOut, prevJSON, prevLevel }()
+
+	Info("task created", "task_id", 7)
+
+	line := buf.String()
+	if !strings.Contains(line, "level=INFO") || !strings.Contains(line, `msg="task created"`) || !strings.Contains(line, "task_id=7") {
+		t.Fatalf("unexpected log line: %q", line)
+	}
+}
+
+func TestLogf_JSONFormat(t *testing.T) {
+	var buf bytes.Buffer
+	prevOut, prevJSON, prevLevel := out, jsonFormat, minLevel
+	out = &buf
+	jsonFormat = true
+	minLevel = LevelDebug
+	defer func() { out, jsonFormat, minLevel = prevOut, prevJSON, prevLevel }()
+
+	Error("db query failed", "error", "timeout")
+
+	var entry map[string]interface{}
+	if err := json.Unmarshal(buf.Bytes(), &entry); err != nil {
+		t.Fatalf("expected valid JSON line, got %q: %v", buf.String(), err)
+	}
+	if entry["level"] != "ERROR" || entry["msg"] != "db query failed" || entry["error"] != "timeout" {
+		t.Fatalf("unexpected log entry: %+v", entry)
+	}
+}
+
+func TestLogf_RespectsMinLevel(t *testing.T) {
+	var buf bytes.Buffer
+	prevOut, prevJSON, prevLevel := out, jsonFormat, minLevel
+	out = &buf
+	jsonFormat = false
+	minLevel = LevelWarn
+	defer func() { out, jsonFormat, minLevel = prevOut, prevJSON, prevLevel }()
+
+	Info("should be suppressed")
+	Debug("should also be suppressed")
+
+	if buf.Len() != 0 {
+		t.Fatalf("expected no output below min level, got %q", buf.String())
+	}
+
+	Warn("should be logged")
+	if buf.Len() == 0 {
+		t.Fatal("expected WARN to be logged")
+	}
+}
+
+func TestConfigure_ReadsLevelAndFormatFromEnv(t *testing.T) {
+	prevLevel, prevJSON := minLevel, jsonFormat
+	defer func() { minLevel, jsonFormat = prevLevel, prevJSON }()
+
+	t.Setenv("LOG_LEVEL", "error")
+	t.Setenv("LOG_FORMAT", "json")
+
+	Configure()
+
+	if minLevel != LevelError {
+		t.Fatalf("expected LevelError, got %v", minLevel)
+	}
+	if !jsonFormat {
+		t.Fatal("expected jsonFormat to be true")
+	}
+}
+
+func TestParseLevel(t *testing.T) {
+	tests := []struct {
+		in   string
+		want Level
+		ok   bool
+	}{
+		{"debug", LevelDebug, true},
+		{"INFO", LevelInfo, true},
+		{"warning", LevelWarn, true},
+		{"ERROR", LevelError, true},
+		{"nonsense", LevelInfo, false},
+	}
+	for _, tc := range tests {
+		got, ok := parseLevel(tc.in)
+		if got != tc.want || ok != tc.ok {
+			t.Errorf("parseLevel(%q) = (%v, %v), want (%v, %v)", tc.in, got, ok, tc.want, tc.ok)
+		}
+	}
+}
@@ -0,0 +1,113 @@
+package log
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"net/http"
+	"sync"
+	"time"
+)
+
+type requestIDKeyType struct{}
+type userIDKeyType struct{}
+
+var (
+	requestIDKey = requestIDKeyType{}
+	userIDKey    = userIDKeyType{}
+)
+
+// RequestIDFromContext returns the X-Request-ID generated by Middleware for
+// the in-flight request, or "" if Middleware did not run.
+func RequestIDFromContext(ctx context.Context) string {
+	id, _ := ctx.Value(requestIDKey).(string)
+	return id
+}
+
+// UserIDHolder is a mutable slot Middleware places in the request context
+// before calling next. pkg/auth's middleware runs further down the chain and
+// only ever sees a context derived via http.Request.WithContext, a copy that
+// never propagates back up to the *http.Request Middleware holds — so rather
+// than read the user id off that context after the fact, Middleware hands
+// down this holder and the auth layer fills it in directly.
+type UserIDHolder struct {
+	mu  sync.Mutex
+	id  int
+	set bool
+}
+
+// Set records the authenticated user id. Called by pkg/auth once a token is
+// validated.
+func (h *UserIDHolder) Set(id int) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.id = id
+	h.set = true
+}
+
+// Get returns the recorded user id, or false if Set was never called.
+func (h *UserIDHolder) Get() (int, bool) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	return h.id, h.set
+}
+
+// UserIDHolderFromContext returns the UserIDHolder placed by Middleware, or
+// false if Middleware did not run.
+func UserIDHolderFromContext(ctx context.Context) (*UserIDHolder, bool) {
+	holder, ok := ctx.Value(userIDKey).(*UserIDHolder)
+	return holder, ok
+}
+
+func newRequestID() string {
+	b := make([]byte, 8)
+	if _, err := rand.Read(b); err != nil {
+		return "unknown"
+	}
+	return hex.EncodeToString(b)
+}
+
+// statusRecorder captures the status code a handler writes, since
+// http.ResponseWriter doesn't expose it after the fact.
+type statusRecorder struct {
+	http.ResponseWriter
+	status int
+}
+
+func (r *statusRecorder) WriteHeader(status int) {
+	r.status = status
+	r.ResponseWriter.WriteHeader(status)
+}
+
+// Middleware generates a request ID, propagates it into the request
+// context and an X-Request-ID response header, and logs one structured
+// line per request with method, path, status, duration, remote addr, and
+// (if authenticated) user id.
+func Middleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requestID := newRequestID()
+		w.Header().Set("X-Request-ID", requestID)
+
+		userHolder := &UserIDHolder{}
+		ctx := context.WithValue(r.Context(), requestIDKey, requestID)
+		ctx = context.WithValue(ctx, userIDKey, userHolder)
+		r = r.WithContext(ctx)
+
+		rec := &statusRecorder{ResponseWriter: w, status: http.StatusOK}
+		start := time.Now()
+		next.ServeHTTP(rec, r)
+
+		fields := []interface{}{
+			"request_id", requestID,
+			"method", r.Method,
+			"path", r.URL.Path,
+			"status", rec.status,
+			"duration_ms", time.Since(start).Milliseconds(),
+			"remote_addr", r.RemoteAddr,
+		}
+		if userID, ok := userHolder.Get(); ok {
+			fields = append(fields, "user_id", userID)
+		}
+		Info("http request", fields...)
+	})
+}
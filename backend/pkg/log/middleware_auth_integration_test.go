@@ -0,0 +1,46 @@
+package log_test
+
+import (
+	"bytes"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"tech-management-backend/pkg/auth"
+	"tech-management-backend/pkg/log"
+)
+
+// This reproduces the exact chain used in production: log.Middleware wraps
+// auth.RequireAuthenticated, which resolves the user on its own derived
+// request context further down the handler chain. log.Middleware must still
+// see that user id when it logs the request line afterwards.
+func TestMiddleware_LogsUserIDThroughRequireAuthenticated(t *testing.T) {
+	authMW := auth.NewMiddleware("test-secret")
+	token, err := authMW.GenerateToken(auth.User{ID: 7, Role: auth.RoleTechnician}, time.Hour)
+	if err != nil {
+		t.Fatalf("GenerateToken: %v", err)
+	}
+
+	var buf bytes.Buffer
+	restore := log.SetOutput(&buf)
+	defer restore()
+
+	handler := log.Middleware(authMW.RequireAuthenticated(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+
+	req := httptest.NewRequest(http.MethodGet, "/api/tasks", nil)
+	req.Header.Set("Authorization", "Bearer "+token)
+	rec := httptest.NewRecorder()
+
+	handler.ServeHTTP(rec, req)
+
+	if rec.Code != http.StatusOK {
+		t.Fatalf("expected 200, got %d", rec.Code)
+	}
+	if !strings.Contains(buf.String(), "user_id=7") {
+		t.Fatalf("expected request log line to include user_id=7, got %q", buf.String())
+	}
+}
@@ -0,0 +1,64 @@
+package log
+
+import (
+	"bytes"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestMiddleware_SetsRequestIDHeaderAndContext(t *testing.T) {
+	var sawRequestID string
+	next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		sawRequestID = RequestIDFromContext(r.Context())
+		w.WriteHeader(http.StatusTeapot)
+	})
+
+	req := httptest.NewRequest(http.MethodGet, "/api/tasks", nil)
+	rec := httptest.NewRecorder()
+
+	Middleware(next).ServeHTTP(rec, req)
+
+	if rec.Code != http.StatusTeapot {
+		t.Fatalf("expected status to pass through, got %d", rec.Code)
+	}
+	headerID := rec.Header().Get("X-Request-ID")
+	if headerID == "" {
+		t.Fatal("expected X-Request-ID response header to be set")
+	}
+	if sawRequestID != headerID {
+		t.Fatalf("expected request context ID %q to match response header %q", sawRequestID, headerID)
+	}
+}
+
+func TestMiddleware_LogsUserIDFilledInByDownstreamHolderSet(t *testing.T) {
+	var buf bytes.Buffer
+	prevOut, prevJSON := out, jsonFormat
+	out = &buf
+	jsonFormat = false
+	defer func() { out, jsonFormat = prevOut, prevJSON }()
+
+	// Simulates what pkg/auth's RequireAuthenticated does: it derives its own
+	// context (as http.Request.WithContext always does, leaving the outer
+	// request unchanged) and reports the user id through the holder rather
+	// than relying on that derived context reaching Middleware's r.Context().
+	next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		holder, ok := UserIDHolderFromContext(r.Context())
+		if !ok {
+			t.Fatal("expected a UserIDHolder in context")
+		}
+		r = r.WithContext(r.Context())
+		holder.Set(42)
+		w.WriteHeader(http.StatusOK)
+	})
+
+	req := httptest.NewRequest(http.MethodGet, "/api/tasks", nil)
+	rec := httptest.NewRecorder()
+
+	Middleware(next).ServeHTTP(rec, req)
+
+	if !strings.Contains(buf.String(), "user_id=42") {
+		t.Fatalf("expected logged line to include user_id=42, got %q", buf.String())
+	}
+}
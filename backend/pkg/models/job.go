@@ -0,0 +1,37 @@
+package models
+
+import (
+	"encoding/json"
+	"time"
+)
+
+// JobType is the kind of long-running operation a job carries out.
+type JobType string
+
+const (
+	JobBulkAssign       JobType = "BULK_ASSIGN"
+	JobExportTasksCSV   JobType = "EXPORT_TASKS_CSV"
+	JobImportTasks      JobType = "IMPORT_TASKS"
+	JobNotifyTechnician JobType = "NOTIFY_TECHNICIAN"
+)
+
+type JobStatus string
+
+const (
+	JobPending   JobStatus = "PENDING"
+	JobRunning   JobStatus = "RUNNING"
+	JobSucceeded JobStatus = "SUCCEEDED"
+	JobFailed    JobStatus = "FAILED"
+)
+
+// Job tracks a long-running background operation. Progress is appended to
+// Log as the job runs and streamed to subscribers of GET /api/jobs/{id}/stream.
+type Job struct {
+	ID         int             `json:"id"`
+	Type       JobType         `json:"type"`
+	Status     JobStatus       `json:"status"`
+	Params     json.RawMessage `json:"params"`
+	StartTime  *time.Time      `json:"startTime"`
+	UpdateTime time.Time       `json:"updateTime"`
+	Log        string          `json:"log"`
+}
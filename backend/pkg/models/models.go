@@ -0,0 +1,39 @@
+// Package models holds the domain types shared across the backend.
+package models
+
+import (
+	"encoding/json"
+	"time"
+)
+
+type UserRole string
+
+const (
+	RoleManager    UserRole = "MANAGER"
+	RoleTechnician UserRole = "TECHNICIAN"
+)
+
+type User struct {
+	ID          int       `json:"id"`
+	Email       string    `json:"email"`
+	Password    string    `json:"-"`
+	Name        string    `json:"name"`
+	Role        UserRole  `json:"role"`
+	OTPSecret   string    `json:"-"`
+	OTPVerified bool      `json:"otpVerified"`
+	CreatedAt   time.Time `json:"createdAt"`
+}
+
+type Task struct {
+	ID              int             `json:"id"`
+	Title           string          `json:"title"`
+	Description     string          `json:"description"`
+	Status          string          `json:"status"`
+	Priority        string          `json:"priority"`
+	TechnicianID    *int            `json:"technicianId"`
+	Client          string          `json:"client"`
+	Notes           string          `json:"notes"`
+	AssigneeHistory json.RawMessage `json:"assigneeHistory"`
+	CreatedAt       time.Time       `json:"createdAt"`
+	UpdatedAt       time.Time       `json:"updatedAt"`
+}
@@ -0,0 +1,43 @@
+package models
+
+import "time"
+
+// AssignmentStrategy picks how a matching policy chooses a technician from
+// its pool.
+type AssignmentStrategy string
+
+const (
+	StrategyRoundRobin  AssignmentStrategy = "ROUND_ROBIN"
+	StrategyLeastLoaded AssignmentStrategy = "LEAST_LOADED"
+	StrategyRandom      AssignmentStrategy = "RANDOM"
+)
+
+// AssignmentPolicy configures automatic technician dispatch for newly
+// created tasks. Policies are evaluated highest Priority first; the first
+// enabled policy whose filters match a task is used to pick a technician.
+type AssignmentPolicy struct {
+	ID               int                `json:"id"`
+	Name             string             `json:"name"`
+	Enabled          bool               `json:"enabled"`
+	Priority         int                `json:"priority"`
+	PriorityFilter   string             `json:"priorityFilter"`
+	ClientFilter     string             `json:"clientFilter"`
+	TechnicianPool   []int              `json:"technicianPool"`
+	Strategy         AssignmentStrategy `json:"strategy"`
+	CronStr          string             `json:"cronStr"`
+	RoundRobinCursor int                `json:"-"`
+	CreatedAt        time.Time          `json:"createdAt"`
+	UpdatedAt        time.Time          `json:"updatedAt"`
+}
+
+// Matches reports whether the policy's priority/client filters apply to
+// task. An empty filter matches any value.
+func (p AssignmentPolicy) Matches(task Task) bool {
+	if p.PriorityFilter != "" && p.PriorityFilter != task.Priority {
+		return false
+	}
+	if p.ClientFilter != "" && p.ClientFilter != task.Client {
+		return false
+	}
+	return true
+}
@@ -0,0 +1,74 @@
+// Package router assembles the HTTP routes for the task management API.
+package router
+
+import (
+	"net/http"
+
+	"tech-management-backend/pkg/auth"
+	"tech-management-backend/pkg/config"
+	authhandlers "tech-management-backend/pkg/handlers/auth"
+	jobhandlers "tech-management-backend/pkg/handlers/jobs"
+	"tech-management-backend/pkg/handlers/policies"
+	"tech-management-backend/pkg/handlers/tasks"
+	"tech-management-backend/pkg/handlers/users"
+	"tech-management-backend/pkg/log"
+)
+
+// New builds the full set of routes, wrapped in CORS handling and
+// authentication/authorization middleware.
+func New(cfg config.Config, authMW *auth.Middleware, authH *authhandlers.Handler, taskH *tasks.Handler, userH *users.Handler, policyH *policies.Handler, jobH *jobhandlers.Handler) http.Handler {
+	mux := http.NewServeMux()
+
+	// Auth
+	mux.HandleFunc("POST /api/auth/signup", authH.Signup)
+	mux.HandleFunc("POST /api/auth/login", authH.Login)
+	mux.HandleFunc("GET /api/auth/me", authMW.RequireAuthenticated(authH.Me))
+	mux.HandleFunc("POST /api/auth/otp/enroll", authMW.RequireAuthenticated(authH.Enroll))
+	mux.HandleFunc("POST /api/auth/otp/verify", authMW.RequireAuthenticated(authH.Verify))
+	mux.HandleFunc("POST /api/auth/otp/disable", authMW.RequireAuthenticated(authH.Disable))
+	mux.HandleFunc("POST /api/auth/otp/challenge", authH.Challenge)
+
+	// Tasks
+	mux.HandleFunc("GET /api/tasks", authMW.RequireAuthenticated(taskH.List))
+	mux.HandleFunc("POST /api/tasks", authMW.RequireRole(auth.RoleManager, taskH.Create))
+	mux.HandleFunc("PUT /api/tasks/{id}", authMW.RequireAuthenticated(taskH.Update))
+	mux.HandleFunc("DELETE /api/tasks/{id}", authMW.RequireRole(auth.RoleManager, taskH.Delete))
+
+	// Technicians & Users
+	mux.HandleFunc("GET /api/technicians", authMW.RequireRole(auth.RoleManager, userH.ListTechnicians))
+	mux.HandleFunc("PUT /api/users/{id}", authMW.RequireRole(auth.RoleManager, userH.Update))
+	mux.HandleFunc("DELETE /api/users/{id}", authMW.RequireRole(auth.RoleManager, userH.Delete))
+	mux.HandleFunc("PATCH /api/users/{id}/role", authMW.RequireRole(auth.RoleManager, userH.UpdateRole))
+
+	// Manager
+	mux.HandleFunc("GET /api/users/managers", authMW.RequireRole(auth.RoleManager, userH.ListManagers))
+
+	// Assignment policies
+	mux.HandleFunc("GET /api/policies", authMW.RequireRole(auth.RoleManager, policyH.List))
+	mux.HandleFunc("POST /api/policies", authMW.RequireRole(auth.RoleManager, policyH.Create))
+	mux.HandleFunc("GET /api/policies/{id}", authMW.RequireRole(auth.RoleManager, policyH.Get))
+	mux.HandleFunc("PUT /api/policies/{id}", authMW.RequireRole(auth.RoleManager, policyH.Update))
+	mux.HandleFunc("DELETE /api/policies/{id}", authMW.RequireRole(auth.RoleManager, policyH.Delete))
+
+	// Background jobs
+	mux.HandleFunc("POST /api/jobs", authMW.RequireRole(auth.RoleManager, jobH.Create))
+	mux.HandleFunc("GET /api/jobs/{id}", authMW.RequireAuthenticated(jobH.Get))
+	mux.HandleFunc("GET /api/jobs/{id}/stream", authMW.RequireAuthenticated(jobH.Stream))
+
+	return log.Middleware(withCORS(cfg, mux))
+}
+
+// withCORS applies CORS headers to every request and short-circuits
+// preflight OPTIONS requests before they reach the method-specific routes.
+func withCORS(cfg config.Config, next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Access-Control-Allow-Origin", cfg.AllowedOrigin)
+		w.Header().Set("Access-Control-Allow-Methods", "POST, GET, OPTIONS, PUT, DELETE, PATCH")
+		w.Header().Set("Access-Control-Allow-Headers", "Accept, Content-Type, Content-Length, Authorization")
+		if r.Method == http.MethodOptions {
+			w.WriteHeader(http.StatusOK)
+			return
+		}
+		next.ServeHTTP(w, r)
+	})
+}